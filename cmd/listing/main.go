@@ -28,17 +28,22 @@ var (
 	yellow = color.New(color.FgYellow)
 
 	vcdEquivalentCA = map[string]string{
-		"vcd_catalog_access_control ":          "cloudavenue_catalog_acl",
-		"vcd_independent_disk":                 "cloudavenue_vm_disk",
+		"vcd_catalog_access_control":           "cloudavenue_catalog_acl",
+		"vcd_catalog_media":                    "cloudavenue_catalog_media",
+		"vcd_catalog_vapp_template":            "cloudavenue_catalog_vapp_template",
+		"vcd_library_certificate":              "cloudavenue_certificate",
+		"vcd_independent_disk":                 "cloudavenue_disk",
 		"vcd_inserted_media":                   "cloudavenue_vm_inserted_media",
 		"vcd_network_isolated_v2":              "cloudavenue_network_isolated",
 		"vcd_network_routed_v2":                "cloudavenue_network_routed",
 		"vcd_nsxt_alb_pool":                    "cloudavenue_alb_pool",
+		"vcd_nsxt_alb_virtual_service":         "cloudavenue_alb_virtual_service",
 		"vcd_nsxt_app_port_profile":            "cloudavenue_edgegateway_app_port_profile",
+		"vcd_nsxt_distributed_firewall":        "cloudavenue_vdc_group_firewall",
 		"vcd_nsxt_edgegateway":                 "cloudavenue_edgegateway",
 		"vcd_nsxt_firewall":                    "cloudavenue_edgegateway_firewall",
 		"vcd_nsxt_ip_set":                      "cloudavenue_edgegateway_ip_set",
-		"vcd_nsxt_ipsec_vpn_tunnel":            "cloudavenue_edgegateway_ipsec_vpn_tunnel",
+		"vcd_nsxt_ipsec_vpn_tunnel":            "cloudavenue_edgegateway_vpn_ipsec",
 		"vcd_nsxt_nat_rule":                    "cloudavenue_edgegateway_nat_rule",
 		"vcd_nsxt_network_dhcp_binding ":       "cloudavenue_network_dhcp_binding",
 		"vcd_nsxt_security_group":              "cloudavenue_edgegateway_security_group",
@@ -46,15 +51,17 @@ var (
 		"vcd_vapp_vm":                          "cloudavenue_vm",
 		"vcd_vapp_access_control":              "cloudavenue_vapp_acl",
 		"vcd_vm_internal_disk":                 "cloudavenue_vm_disk",
-		"vcd_org_group ":                       "cloudavenue_iam_group",
+		"vcd_org_group":                        "cloudavenue_iam_group",
 		"vcd_org_user":                         "cloudavenue_iam_user",
 		"vcd_org_vdc":                          "cloudavenue_vdc",
 		"vcd_org_vdc_access_control":           "cloudavenue_vdc_acl",
+		"vcd_vdc_group":                        "cloudavenue_vdc_group",
 		"vcd_role":                             "cloudavenue_iam_role",
 		"vcd_security_tag":                     "cloudavenue_vm_security_tag",
 		"vcd_right":                            "cloudavenue_iam_right",
 		"vcd_nsxt_network_dhcp":                "cloudavenue_network_dhcp",
 		"vcd_nsxt_network_dhcp_binding":        "cloudavenue_network_dhcp_binding",
+		"vcd_nsxt_network_segment_profile":     "cloudavenue_network_segment_profile",
 		"vcd_vm":                               "cloudavenue_vm",
 		"vcd_nsxt_edgegateway_dhcp_forwarding": "cloudavenue_edgegateway_dhcp_forwarding",
 		"vcd_nsxt_edgegateway_static_route":    "cloudavenue_edgegateway_static_route",
@@ -82,7 +89,6 @@ var (
 		"vcd_nsxt_alb_edgegateway_service_engine_group",
 		"vcd_nsxt_alb_service_engine_group",
 		"vcd_nsxt_alb_settings",
-		"vcd_nsxt_alb_virtual_service",
 		"vcd_nsxt_edgegateway_bgp_configuration",
 		"vcd_nsxt_edgegateway_bgp_ip_prefix_list",
 		"vcd_nsxt_edgegateway_bgp_neighbor",
@@ -101,11 +107,9 @@ var (
 		"vcd_provider_vdc",
 		"vcd_rights_bundle",
 		"vcd_subscribed_catalog",
-		"vcd_vdc_group",
 		"vcd_vm",
 		"vcd_vm_placement_policy",
 		"vcd_vm_sizing_policy",
-		"vcd_org_group",       // Manage group for LDAP and SAML
 		"vcd_resource_schema", // Generic data source.
 		"vcd_resource_pool",   // Require Admin Org
 		"vcd_resource_list",   // Generic data source.
@@ -0,0 +1,4 @@
+data "cloudavenue_alb_virtual_service" "example" {
+  edge_gateway_name = "MyEdgeGateway"
+  name              = "example"
+}
@@ -0,0 +1,3 @@
+data "cloudavenue_certificate" "example" {
+  alias = "example-certificate"
+}
@@ -0,0 +1,2 @@
+data "cloudavenue_disks" "example" {
+}
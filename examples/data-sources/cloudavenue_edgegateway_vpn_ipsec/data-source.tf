@@ -0,0 +1,8 @@
+data "cloudavenue_edgegateway_vpn_ipsec" "example" {
+  edge_gateway_name = "myEdgeName"
+  name              = "example"
+}
+
+output "vpn_status" {
+  value = data.cloudavenue_edgegateway_vpn_ipsec.example.status
+}
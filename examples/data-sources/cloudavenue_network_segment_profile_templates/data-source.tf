@@ -0,0 +1,2 @@
+data "cloudavenue_network_segment_profile_templates" "example" {
+}
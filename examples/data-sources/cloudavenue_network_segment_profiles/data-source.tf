@@ -0,0 +1,3 @@
+data "cloudavenue_network_segment_profiles" "example" {
+  vdc = "VDC_Test"
+}
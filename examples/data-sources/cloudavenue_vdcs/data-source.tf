@@ -3,3 +3,10 @@ data "cloudavenue_vdcs" "example" {}
 output "example" {
   value = data.cloudavenue_vdcs.example
 }
+
+# Only return the vDCs tagged with the metadata env = production
+data "cloudavenue_vdcs" "production" {
+  metadata_filter = {
+    env = "production"
+  }
+}
@@ -0,0 +1,2 @@
+# use the edge_gateway_name.alb_virtual_service_name to import the ALB Virtual Service
+terraform import cloudavenue_alb_virtual_service.example edge_gateway_name.alb_virtual_service_name
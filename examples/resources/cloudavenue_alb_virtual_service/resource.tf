@@ -0,0 +1,42 @@
+data "cloudavenue_tier0_vrfs" "example" {}
+
+resource "cloudavenue_edgegateway" "example" {
+  owner_name     = "MyVDC"
+  tier0_vrf_name = data.cloudavenue_tier0_vrfs.example.names.0
+  owner_type     = "vdc"
+  lb_enabled     = true
+}
+
+resource "cloudavenue_alb_pool" "example" {
+  edge_gateway_name = cloudavenue_edgegateway.example.name
+  name              = "Example"
+
+  members = [
+    {
+      ip_address = "192.168.1.1"
+      port       = "80"
+    },
+    {
+      ip_address = "192.168.1.2"
+      port       = "80"
+    }
+  ]
+}
+
+resource "cloudavenue_alb_virtual_service" "example" {
+  edge_gateway_name        = cloudavenue_edgegateway.example.name
+  name                     = "example"
+  virtual_ip               = "192.168.10.10"
+  application_profile_type = "HTTP"
+  pool_name                = cloudavenue_alb_pool.example.name
+
+  service_ports = [
+    {
+      start = 80
+    },
+    {
+      start = 8080
+      end   = 8090
+    }
+  ]
+}
@@ -4,4 +4,15 @@ resource "cloudavenue_catalog" "example" {
 	description      = "catalog for ISO"
 	delete_recursive = true
 	delete_force     = true
+	metadata = [
+		{
+			key   = "env"
+			value = "production"
+		},
+		{
+			key      = "owner"
+			value    = "team-cloud"
+			readonly = true
+		}
+	]
 }
\ No newline at end of file
@@ -0,0 +1,2 @@
+# use the catalog name or the catalog ID to import the resource
+terraform import cloudavenue_catalog_acl.example catalog_name
@@ -0,0 +1,9 @@
+resource "cloudavenue_catalog" "example" {
+  name        = "catalog-example"
+  description = "catalog for ISO"
+}
+
+resource "cloudavenue_catalog_acl" "example" {
+  catalog_id            = cloudavenue_catalog.example.id
+  everyone_access_level = "ReadOnly"
+}
@@ -0,0 +1,2 @@
+# use the catalog_name.media_name to import the media
+terraform import cloudavenue_catalog_media.example catalog_name.media_name
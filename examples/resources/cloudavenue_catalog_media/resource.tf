@@ -0,0 +1,17 @@
+resource "cloudavenue_catalog" "example" {
+  name             = "iso-catalog"
+  description      = "catalog for ISO"
+  delete_recursive = true
+  delete_force     = true
+}
+
+resource "cloudavenue_catalog_media" "example" {
+  catalog_name      = cloudavenue_catalog.example.name
+  name              = "debian-12-netinst"
+  description       = "Debian 12 network installer"
+  file_path         = "${path.module}/debian-12-amd64-netinst.iso"
+  upload_piece_size = 10
+
+  # Eject the media from the VMs before deleting it
+  force = true
+}
@@ -0,0 +1,2 @@
+# use the catalog_name.template_name to import the vApp Template
+terraform import cloudavenue_catalog_vapp_template.example catalog_name.template_name
@@ -0,0 +1,40 @@
+resource "cloudavenue_catalog" "example" {
+  name             = "golden-images"
+  description      = "catalog for golden images"
+  delete_recursive = true
+  delete_force     = true
+}
+
+# Upload from a local OVA file
+resource "cloudavenue_catalog_vapp_template" "example" {
+  catalog_name      = cloudavenue_catalog.example.name
+  template_name     = "ubuntu-22.04"
+  description       = "Ubuntu 22.04 golden image"
+  file_path         = "${path.module}/ubuntu-22.04.ova"
+  upload_piece_size = 10
+
+  timeouts = {
+    create = "2h"
+  }
+}
+
+# Upload from a URL
+resource "cloudavenue_catalog_vapp_template" "example_url" {
+  catalog_id    = cloudavenue_catalog.example.id
+  template_name = "debian-12"
+  url           = "https://example.com/images/debian-12.ovf"
+}
+
+# Capture an existing vApp
+resource "cloudavenue_vapp" "example" {
+  name        = "image-factory"
+  description = "vApp prepared by the image factory"
+}
+
+resource "cloudavenue_catalog_vapp_template" "example_capture" {
+  catalog_id               = cloudavenue_catalog.example.id
+  template_name            = "image-factory"
+  vapp_id                  = cloudavenue_vapp.example.id
+  customize_on_instantiate = true
+  overwrite                = true
+}
@@ -0,0 +1,2 @@
+# use the certificate alias or ID to import the certificate
+terraform import cloudavenue_certificate.example example-certificate
@@ -0,0 +1,6 @@
+resource "cloudavenue_certificate" "example" {
+  alias       = "example-certificate"
+  description = "This is an example certificate"
+  certificate = file("cert.pem")
+  private_key = file("key.pem")
+}
@@ -0,0 +1,2 @@
+# use the disk ID or vdc.disk_id to import the disk
+terraform import cloudavenue_disk.example urn:vcloud:disk:12345678-1234-1234-1234-123456789012
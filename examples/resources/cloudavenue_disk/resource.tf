@@ -0,0 +1,6 @@
+resource "cloudavenue_disk" "example" {
+  name        = "disk-example"
+  description = "This is an example independent disk"
+  size_in_mb  = 2048
+  bus_type    = "SATA"
+}
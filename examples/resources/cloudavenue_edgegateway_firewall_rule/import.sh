@@ -0,0 +1,2 @@
+# use the edge gateway name or ID and the rule name or ID to import the firewall rule
+terraform import cloudavenue_edgegateway_firewall_rule.example EdgeGatewayNameOrID.RuleNameOrID
@@ -0,0 +1,17 @@
+data "cloudavenue_edgegateways" "example" {}
+
+resource "cloudavenue_edgegateway_firewall_rule" "example" {
+  edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+  name            = "allow IN IPv4 traffic"
+  action          = "ALLOW"
+  direction       = "IN"
+  ip_protocol     = "IPV4"
+}
+
+resource "cloudavenue_edgegateway_firewall_rule" "example_before" {
+  edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+  name            = "drop OUT IPv4 traffic"
+  action          = "DROP"
+  direction       = "OUT"
+  before_rule_id  = cloudavenue_edgegateway_firewall_rule.example.id
+}
@@ -0,0 +1 @@
+terraform import cloudavenue_edgegateway_nat_rule.example edgeGatewayIDOrName.natRuleIDOrName
@@ -0,0 +1,19 @@
+data "cloudavenue_edgegateways" "example" {}
+
+resource "cloudavenue_publicip" "example" {
+  edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+}
+
+resource "cloudavenue_edgegateway_nat_rule" "example" {
+  edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+
+  name        = "example-snat"
+  rule_type   = "SNAT"
+  description = "description SNAT example"
+
+  external_address         = cloudavenue_publicip.example.public_ip
+  internal_address         = "11.11.11.0/24"
+  snat_destination_address = "8.8.8.8"
+
+  priority = 10
+}
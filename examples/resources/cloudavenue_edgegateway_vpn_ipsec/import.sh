@@ -0,0 +1 @@
+terraform import cloudavenue_edgegateway_vpn_ipsec.example edgeGatewayIDOrName.vpnIPSecIDOrName
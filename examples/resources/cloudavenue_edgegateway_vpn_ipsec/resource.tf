@@ -0,0 +1,31 @@
+data "cloudavenue_edgegateways" "example" {}
+
+resource "cloudavenue_publicip" "example" {
+  edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+}
+
+resource "cloudavenue_edgegateway_vpn_ipsec" "example" {
+  edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+  name            = "example"
+  description     = "example VPN IPSec"
+  pre_shared_key  = "my-secret-psk"
+
+  local_ip_address = cloudavenue_publicip.example.public_ip
+  local_networks   = ["10.10.10.0/24"]
+
+  remote_ip_address = "1.2.3.4"
+  remote_networks   = ["192.168.1.0/24"]
+
+  security_profile = {
+    ike_version              = "IKE_V2"
+    ike_encryption_algorithm = "AES_256"
+    ike_digest_algorithm     = "SHA2_512"
+    ike_dh_group             = "GROUP15"
+
+    tunnel_encryption_algorithm = "AES_256"
+    tunnel_digest_algorithm     = "SHA2_512"
+    tunnel_dh_group             = "GROUP15"
+
+    dpd_probe_interval = 30
+  }
+}
@@ -1,5 +1,5 @@
 resource "cloudavenue_iam_group" "example" {
   name        = "OrgTest"
-  role        = "Organization Administrator"
+  role_name   = "Organization Administrator"
   description = "org test from go test"
-}
\ No newline at end of file
+}
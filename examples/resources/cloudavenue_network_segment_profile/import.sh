@@ -0,0 +1 @@
+terraform import cloudavenue_network_segment_profile.example urn:vcloud:network:xxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxx
\ No newline at end of file
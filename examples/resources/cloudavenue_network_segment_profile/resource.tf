@@ -0,0 +1,30 @@
+data "cloudavenue_network_segment_profile_templates" "example" {}
+
+resource "cloudavenue_network_segment_profile" "example" {
+  org_network_id              = cloudavenue_network_routed.example.id
+  segment_profile_template_id = data.cloudavenue_network_segment_profile_templates.example.segment_profile_templates[0].id
+}
+
+data "cloudavenue_edgegateways" "example" {}
+
+resource "cloudavenue_network_routed" "example" {
+  name        = "MyOrgNet"
+  description = "This is an example Net"
+
+  edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+
+  gateway       = "192.168.1.254"
+  prefix_length = 24
+
+  dns1 = "1.1.1.1"
+  dns2 = "8.8.8.8"
+
+  dns_suffix = "example"
+
+  static_ip_pool = [
+    {
+      start_address = "192.168.1.10"
+      end_address   = "192.168.1.20"
+    }
+  ]
+}
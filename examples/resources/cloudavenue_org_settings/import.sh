@@ -0,0 +1 @@
+terraform import cloudavenue_org_settings.example org
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "cloudavenue_org_settings" "example" {
+  vapp_lease = {
+    runtime_lease_in_sec                  = 0
+    storage_lease_in_sec                  = 2592000
+    delete_on_storage_lease_expiration    = false
+    power_off_on_runtime_lease_expiration = true
+  }
+
+  vapp_template_lease = {
+    storage_lease_in_sec               = 0
+    delete_on_storage_lease_expiration = false
+  }
+
+  deployed_vm_quota = 0
+  stored_vm_quota   = 0
+}
@@ -0,0 +1,2 @@
+# use the vdc group name or id to import the resource
+terraform import cloudavenue_vdc_group.example MyVDCGroup
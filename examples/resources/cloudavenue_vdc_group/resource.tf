@@ -0,0 +1,12 @@
+data "cloudavenue_vdc" "example" {
+  name = "VDC_Test"
+}
+
+resource "cloudavenue_vdc_group" "example" {
+  name        = "MyVDCGroup"
+  description = "This is an example vDC Group"
+  vdc_ids = [
+    data.cloudavenue_vdc.example.id
+  ]
+  dfw_enabled = true
+}
@@ -0,0 +1,17 @@
+resource "cloudavenue_vdc_group_firewall" "example" {
+  vdc_group_name = "MyVDCGroup"
+  rules = [
+    {
+      name      = "allow all IPv4 traffic"
+      direction = "IN_OUT"
+      action    = "ALLOW"
+    },
+    {
+      name        = "drop IPv6 traffic"
+      direction   = "IN_OUT"
+      ip_protocol = "IPV6"
+      action      = "DROP"
+      logging     = true
+    }
+  ]
+}
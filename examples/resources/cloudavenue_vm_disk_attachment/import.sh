@@ -0,0 +1,2 @@
+# use the vapp_name.vm_name_or_id.disk_id or vdc.vapp_name.vm_name_or_id.disk_id to import the disk attachment
+terraform import cloudavenue_vm_disk_attachment.example vapp_example.example-vm.urn:vcloud:disk:12345678-1234-1234-1234-123456789012
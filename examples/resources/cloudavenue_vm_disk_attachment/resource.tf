@@ -0,0 +1,34 @@
+resource "cloudavenue_vapp" "example" {
+  name        = "vapp_example"
+  description = "This is a example vapp"
+}
+
+data "cloudavenue_catalog_vapp_template" "example" {
+  catalog_name  = "Orange-Linux"
+  template_name = "debian_10_X64"
+}
+
+resource "cloudavenue_vm" "example" {
+  name      = "example-vm"
+  vapp_name = cloudavenue_vapp.example.name
+  deploy_os = {
+    vapp_template_id = data.cloudavenue_catalog_vapp_template.example.id
+  }
+  settings = {
+    customization = {}
+  }
+  resource = {}
+  state    = {}
+}
+
+resource "cloudavenue_disk" "example" {
+  name       = "disk-example"
+  size_in_mb = 2048
+  bus_type   = "SATA"
+}
+
+resource "cloudavenue_vm_disk_attachment" "example" {
+  vapp_name = cloudavenue_vapp.example.name
+  vm_name   = cloudavenue_vm.example.name
+  disk_id   = cloudavenue_disk.example.id
+}
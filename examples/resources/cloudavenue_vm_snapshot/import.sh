@@ -0,0 +1,6 @@
+# If `vDC` is not specified, the default `vDC` will be used
+# The VM can be specified by its name or its ID.
+terraform import cloudavenue_vm_snapshot.example myVAPP.myVM
+
+# or you can specify the vDC
+terraform import cloudavenue_vm_snapshot.example myVDC.myVAPP.myVM
@@ -0,0 +1,9 @@
+resource "cloudavenue_vm_snapshot" "example" {
+  vapp_name   = "vapp-example"
+  vm_name     = "vm-example"
+  description = "snapshot before patching"
+  memory      = true
+
+  # Change this value to revert the VM to the snapshot
+  revert_trigger = "patch-2023-08"
+}
@@ -1,14 +1,59 @@
 package client
 
 import (
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 )
 
+// ErrOrgSettingsLinkNotFound is returned when the organization does not expose the link to a settings section.
+var ErrOrgSettingsLinkNotFound = errors.New("organization settings link not found")
+
+const (
+	mimeOrgGeneralSettings           = "application/vnd.vmware.admin.organizationGeneralSettings+xml"
+	mimeOrgVAppLeaseSettings         = "application/vnd.vmware.admin.vAppLeaseSettings+xml"
+	mimeOrgVAppTemplateLeaseSettings = "application/vnd.vmware.admin.vAppTemplateLeaseSettings+xml"
+)
+
 type AdminOrg struct {
 	*govcd.AdminOrg
+	client *govcd.Client
+}
+
+// orgGeneralSettings is the XML body of the request updating the general settings of an organization.
+// The govcd type omits the zero values, a quota of 0 (unlimited) could not be set.
+type orgGeneralSettings struct {
+	XMLName                  xml.Name `xml:"OrgGeneralSettings"`
+	Xmlns                    string   `xml:"xmlns,attr"`
+	CanPublishCatalogs       bool     `xml:"CanPublishCatalogs"`
+	CanPublishExternally     bool     `xml:"CanPublishExternally"`
+	CanSubscribe             bool     `xml:"CanSubscribe"`
+	DeployedVMQuota          int      `xml:"DeployedVMQuota"`
+	StoredVMQuota            int      `xml:"StoredVmQuota"`
+	UseServerBootSequence    bool     `xml:"UseServerBootSequence"`
+	DelayAfterPowerOnSeconds int      `xml:"DelayAfterPowerOnSeconds"`
+}
+
+// orgVAppLeaseSettings is the XML body of the request updating the vApp lease settings of an organization.
+type orgVAppLeaseSettings struct {
+	XMLName                          xml.Name `xml:"VAppLeaseSettings"`
+	Xmlns                            string   `xml:"xmlns,attr"`
+	DeleteOnStorageLeaseExpiration   *bool    `xml:"DeleteOnStorageLeaseExpiration,omitempty"`
+	DeploymentLeaseSeconds           *int     `xml:"DeploymentLeaseSeconds,omitempty"`
+	StorageLeaseSeconds              *int     `xml:"StorageLeaseSeconds,omitempty"`
+	PowerOffOnRuntimeLeaseExpiration *bool    `xml:"PowerOffOnRuntimeLeaseExpiration,omitempty"`
+}
+
+// orgVAppTemplateLeaseSettings is the XML body of the request updating the vApp Template lease settings of an organization.
+type orgVAppTemplateLeaseSettings struct {
+	XMLName                        xml.Name `xml:"VAppTemplateLeaseSettings"`
+	Xmlns                          string   `xml:"xmlns,attr"`
+	DeleteOnStorageLeaseExpiration *bool    `xml:"DeleteOnStorageLeaseExpiration,omitempty"`
+	StorageLeaseSeconds            *int     `xml:"StorageLeaseSeconds,omitempty"`
 }
 
 // GetAdminOrg return the admin org using the name provided in the provider.
@@ -18,7 +63,7 @@ func (c *CloudAvenue) GetAdminOrg() (*AdminOrg, error) {
 		return nil, fmt.Errorf("%w: %w", ErrRetrievingOrg, err)
 	}
 
-	return &AdminOrg{x}, nil
+	return &AdminOrg{AdminOrg: x, client: &c.Vmware.Client}, nil
 }
 
 /*
@@ -34,3 +79,72 @@ func (ao *AdminOrg) ListCatalogs() *govcdtypes.CatalogsList {
 func (ao *AdminOrg) GetOrgVAppLeaseSettings() *govcdtypes.VAppLeaseSettings {
 	return ao.AdminOrg.AdminOrg.OrgSettings.OrgVAppLeaseSettings
 }
+
+// GetOrgVAppTemplateLeaseSettings retrieves the lease settings for a vApp Template in the specified organization.
+func (ao *AdminOrg) GetOrgVAppTemplateLeaseSettings() *govcdtypes.VAppTemplateLeaseSettings {
+	return ao.AdminOrg.AdminOrg.OrgSettings.OrgVAppTemplateSettings
+}
+
+// GetOrgGeneralSettings retrieves the general settings (VM quotas, catalog publishing, ...) of the organization.
+func (ao *AdminOrg) GetOrgGeneralSettings() *govcdtypes.OrgGeneralSettings {
+	return ao.AdminOrg.AdminOrg.OrgSettings.OrgGeneralSettings
+}
+
+// UpdateOrgGeneralSettings updates the general settings of the organization and refreshes the organization.
+// Only the settings section is updated, updating the whole organization requires the system administrator rights.
+func (ao *AdminOrg) UpdateOrgGeneralSettings(settings *govcdtypes.OrgGeneralSettings) error {
+	current := ao.GetOrgGeneralSettings()
+	if current == nil || current.HREF == "" {
+		return fmt.Errorf("%w: general settings", ErrOrgSettingsLinkNotFound)
+	}
+
+	return ao.updateSettings(current.HREF, mimeOrgGeneralSettings, &orgGeneralSettings{
+		Xmlns:                    govcdtypes.XMLNamespaceVCloud,
+		CanPublishCatalogs:       settings.CanPublishCatalogs,
+		CanPublishExternally:     settings.CanPublishExternally,
+		CanSubscribe:             settings.CanSubscribe,
+		DeployedVMQuota:          settings.DeployedVMQuota,
+		StoredVMQuota:            settings.StoredVMQuota,
+		UseServerBootSequence:    settings.UseServerBootSequence,
+		DelayAfterPowerOnSeconds: settings.DelayAfterPowerOnSeconds,
+	})
+}
+
+// UpdateOrgVAppLeaseSettings updates the vApp lease settings of the organization and refreshes the organization.
+func (ao *AdminOrg) UpdateOrgVAppLeaseSettings(settings *govcdtypes.VAppLeaseSettings) error {
+	current := ao.GetOrgVAppLeaseSettings()
+	if current == nil || current.HREF == "" {
+		return fmt.Errorf("%w: vApp lease settings", ErrOrgSettingsLinkNotFound)
+	}
+
+	return ao.updateSettings(current.HREF, mimeOrgVAppLeaseSettings, &orgVAppLeaseSettings{
+		Xmlns:                            govcdtypes.XMLNamespaceVCloud,
+		DeleteOnStorageLeaseExpiration:   settings.DeleteOnStorageLeaseExpiration,
+		DeploymentLeaseSeconds:           settings.DeploymentLeaseSeconds,
+		StorageLeaseSeconds:              settings.StorageLeaseSeconds,
+		PowerOffOnRuntimeLeaseExpiration: settings.PowerOffOnRuntimeLeaseExpiration,
+	})
+}
+
+// UpdateOrgVAppTemplateLeaseSettings updates the vApp Template lease settings of the organization and refreshes the organization.
+func (ao *AdminOrg) UpdateOrgVAppTemplateLeaseSettings(settings *govcdtypes.VAppTemplateLeaseSettings) error {
+	current := ao.GetOrgVAppTemplateLeaseSettings()
+	if current == nil || current.HREF == "" {
+		return fmt.Errorf("%w: vApp Template lease settings", ErrOrgSettingsLinkNotFound)
+	}
+
+	return ao.updateSettings(current.HREF, mimeOrgVAppTemplateLeaseSettings, &orgVAppTemplateLeaseSettings{
+		Xmlns:                          govcdtypes.XMLNamespaceVCloud,
+		DeleteOnStorageLeaseExpiration: settings.DeleteOnStorageLeaseExpiration,
+		StorageLeaseSeconds:            settings.StorageLeaseSeconds,
+	})
+}
+
+// updateSettings puts a settings section of the organization and refreshes the organization.
+func (ao *AdminOrg) updateSettings(href, mime string, payload interface{}) error {
+	if err := ao.client.ExecuteRequestWithoutResponse(href, http.MethodPut, mime, "error updating organization settings: %s", payload); err != nil {
+		return err
+	}
+
+	return ao.Refresh()
+}
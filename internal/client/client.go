@@ -5,7 +5,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 
@@ -28,10 +30,26 @@ type CloudAvenue struct {
 	VDC                string
 	User               string
 	Password           string
+	APIToken           string
+	Token              string
 	URL                string
 	TerraformVersion   string
 	CloudAvenueVersion string
 
+	// Retry of the requests failing with a transient error
+	MaxRetries   int
+	RetryMaxWait time.Duration
+	// LogContext is used to log the retries of the requests sent without a context.
+	LogContext context.Context
+
+	// HTTP transport
+	ProxyURL    string
+	CAFile      string
+	CAPEM       string
+	Insecure    bool
+	HTTPTimeout time.Duration
+	transport   *http.Transport
+
 	// API CLOUDAVENUE
 	APIClient *apiclient.APIClient
 	Auth      context.Context
@@ -44,21 +62,16 @@ type CloudAvenue struct {
 
 // New creates a new CloudAvenue client.
 func (c *CloudAvenue) New() (*CloudAvenue, error) {
-	// API CLOUDAVENUE
-	auth := c.createBasicAuthContext()
-	cfg := c.createConfiguration()
-
-	c.APIClient = apiclient.NewAPIClient(cfg)
-	_, ret, err := c.APIClient.AuthenticationApi.GetToken(auth)
+	// HTTP transport shared by both APIs
+	transport, err := c.createTransport()
 	if err != nil {
-		return nil, fmt.Errorf("%w : %w", ErrAuthFailed, err)
-	}
-	token := ret.Header.Get("x-vmware-vcloud-access-token")
-	if token == "" {
-		return nil, ErrTokenEmpty
+		return nil, fmt.Errorf("%w : %w", ErrConfigureTransport, err)
 	}
+	c.transport = transport
 
-	c.Auth = createTokenInContext(token)
+	// API CLOUDAVENUE
+	cfg := c.createConfiguration()
+	c.APIClient = apiclient.NewAPIClient(cfg)
 
 	// API VMWARE
 	err = c.configureVmware()
@@ -67,11 +80,25 @@ func (c *CloudAvenue) New() (*CloudAvenue, error) {
 	}
 
 	if c.VCDVersion == "" {
-		return nil, fmt.Errorf("%w : %w", ErrVCDVersionEmpty, err)
+		return nil, ErrVCDVersionEmpty
+	}
+
+	c.Vmware = govcd.NewVCDClient(
+		*c.urlVmware,
+		c.Insecure,
+		govcd.WithAPIVersion(c.VCDVersion),
+		withTransport(c.transport, c.HTTPTimeout),
+		withRetry(c.retryPolicy()),
+	)
+
+	token, authHeader, err := c.getToken()
+	if err != nil {
+		return nil, err
 	}
 
-	c.Vmware = govcd.NewVCDClient(*c.urlVmware, false, govcd.WithAPIVersion(c.VCDVersion))
-	err = c.Vmware.SetToken(c.Org, govcd.AuthorizationHeader, token)
+	c.Auth = createTokenInContext(token)
+
+	err = c.Vmware.SetToken(c.Org, authHeader, token)
 	if err != nil {
 		return nil, fmt.Errorf("%w : %w", ErrConfigureVmware, err)
 	}
@@ -79,6 +106,48 @@ func (c *CloudAvenue) New() (*CloudAvenue, error) {
 	return c, nil
 }
 
+// getToken returns the access token and the header used to authenticate on the Vmware API.
+// The token is the one provided, or is retrieved from the API token, or from the user and password.
+func (c *CloudAvenue) getToken() (token, authHeader string, err error) {
+	switch {
+	case c.Token != "":
+		return c.Token, govcd.BearerTokenHeader, nil
+
+	case c.APIToken != "":
+		tokenRefresh, err := c.Vmware.GetBearerTokenFromApiToken(c.Org, c.APIToken)
+		if err != nil {
+			return "", "", fmt.Errorf("%w : %w", ErrAuthFailed, err)
+		}
+		if tokenRefresh.AccessToken == "" {
+			return "", "", ErrTokenEmpty
+		}
+		return tokenRefresh.AccessToken, govcd.BearerTokenHeader, nil
+
+	default:
+		_, ret, err := c.APIClient.AuthenticationApi.GetToken(c.createBasicAuthContext())
+		if err != nil {
+			return "", "", fmt.Errorf("%w : %w", ErrAuthFailed, err)
+		}
+		token := ret.Header.Get("x-vmware-vcloud-access-token")
+		if token == "" {
+			return "", "", ErrTokenEmpty
+		}
+		return token, govcd.AuthorizationHeader, nil
+	}
+}
+
+// AuthMethod returns the name of the authentication method used by the client.
+func (c *CloudAvenue) AuthMethod() string {
+	switch {
+	case c.Token != "":
+		return "token"
+	case c.APIToken != "":
+		return "API token"
+	default:
+		return "user and password"
+	}
+}
+
 // createBasicAuthContext creates a new context with the basic auth values.
 func (c *CloudAvenue) createBasicAuthContext() context.Context {
 	// Create a new CloudAvenue client using the configuration values
@@ -96,11 +165,21 @@ func (c *CloudAvenue) createConfiguration() *apiclient.Configuration {
 		BasePath:      c.URL,
 		DefaultHeader: make(map[string]string),
 		UserAgent:     c.createUserAgent(),
+		HTTPClient:    c.createHTTPClient(),
 	}
 
 	return cfg
 }
 
+// retryPolicy returns the policy used to retry the requests failing with a transient error.
+func (c *CloudAvenue) retryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: c.MaxRetries,
+		MaxWait:    c.RetryMaxWait,
+		LogContext: c.LogContext,
+	}
+}
+
 // configurVmware creates a new configuration for the Vmware client.
 func (c *CloudAvenue) configureVmware() (err error) {
 	c.urlVmware, err = url.Parse(fmt.Sprintf("%s/api", c.GetURL()))
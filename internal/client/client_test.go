@@ -5,6 +5,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
 	apiclient "github.com/orange-cloudavenue/cloudavenue-sdk-go"
 )
 
@@ -73,6 +75,46 @@ func TestCloudAvenueClient(t *testing.T) {
 		}
 	})
 
+	t.Run("GetTokenFromToken", func(t *testing.T) {
+		t.Parallel()
+
+		ca := CloudAvenue{
+			Token: "t0k3n",
+		}
+
+		token, authHeader, err := ca.getToken()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if token != "t0k3n" {
+			t.Fatalf("expected token to be %s, got %s", "t0k3n", token)
+		}
+
+		if authHeader != govcd.BearerTokenHeader {
+			t.Fatalf("expected auth header to be %s, got %s", govcd.BearerTokenHeader, authHeader)
+		}
+	})
+
+	t.Run("AuthMethod", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			ca       CloudAvenue
+			expected string
+		}{
+			{CloudAvenue{User: "dasilva", Password: "dasilva"}, "user and password"},
+			{CloudAvenue{APIToken: "4p1t0k3n"}, "API token"},
+			{CloudAvenue{Token: "t0k3n"}, "token"},
+		}
+
+		for _, tc := range testCases {
+			if method := tc.ca.AuthMethod(); method != tc.expected {
+				t.Fatalf("expected auth method to be %q, got %q", tc.expected, method)
+			}
+		}
+	})
+
 	t.Run("CreateUserAgent", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+var (
+	// ErrMediaCatalogLinkNotFound is returned when the catalog does not expose the link to add a media.
+	ErrMediaCatalogLinkNotFound = errors.New("catalog link to add a media not found")
+	// ErrMediaUploadLinkNotFound is returned when the media does not expose the link to upload its file.
+	ErrMediaUploadLinkNotFound = errors.New("media upload link not found")
+)
+
+const (
+	mimeMedia = "application/vnd.vmware.vcloud.media+xml"
+
+	// MediaImageTypeFloppy is the image type of a floppy media.
+	MediaImageTypeFloppy = "floppy"
+)
+
+// createMediaParams is the XML body of the request creating a media in a catalog.
+type createMediaParams struct {
+	XMLName     xml.Name `xml:"Media"`
+	Xmlns       string   `xml:"xmlns,attr"`
+	Name        string   `xml:"name,attr"`
+	ImageType   string   `xml:"imageType,attr"`
+	Size        int64    `xml:"size,attr"`
+	Description string   `xml:"Description,omitempty"`
+}
+
+// UploadFloppyMedia uploads a floppy image in a catalog and returns the import task (nil if the import is already finished).
+// govcd only uploads ISO images. A floppy image is small, the file is uploaded in a single request.
+func (c *CloudAvenue) UploadFloppyMedia(catalog *govcd.Catalog, name, description, filePath string) (*govcd.Task, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	var addLink *govcdtypes.Link
+	for _, link := range catalog.Catalog.Link {
+		if link != nil && link.Rel == "add" && link.Type == mimeMedia {
+			addLink = link
+			break
+		}
+	}
+	if addLink == nil {
+		return nil, ErrMediaCatalogLinkNotFound
+	}
+
+	catalogItem := &govcdtypes.CatalogItem{}
+	if _, err := c.Vmware.Client.ExecuteRequest(addLink.HREF, http.MethodPost, mimeMedia, "error creating media: %s", &createMediaParams{
+		Xmlns:       govcdtypes.XMLNamespaceVCloud,
+		Name:        name,
+		ImageType:   MediaImageTypeFloppy,
+		Size:        fileInfo.Size(),
+		Description: description,
+	}, catalogItem); err != nil {
+		return nil, err
+	}
+
+	if catalogItem.Entity == nil {
+		return nil, fmt.Errorf("error creating media %s: the catalog item has no entity", name)
+	}
+
+	media, err := catalog.GetMediaByHref(catalogItem.Entity.HREF)
+	if err != nil {
+		return nil, err
+	}
+
+	if media.Media.Files == nil || len(media.Media.Files.File) == 0 || len(media.Media.Files.File[0].Link) == 0 {
+		return nil, ErrMediaUploadLinkNotFound
+	}
+
+	uploadURL, err := url.ParseRequestURI(media.Media.Files.File[0].Link[0].HREF)
+	if err != nil {
+		return nil, err
+	}
+
+	req := c.Vmware.Client.NewRequest(map[string]string{}, http.MethodPut, *uploadURL, file)
+	req.ContentLength = fileInfo.Size()
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := c.Vmware.Client.Http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error uploading media %s: %s", name, resp.Status)
+	}
+
+	if err := media.Refresh(); err != nil {
+		return nil, err
+	}
+
+	if media.Media.Tasks == nil || len(media.Media.Tasks.Task) == 0 {
+		return nil, nil
+	}
+
+	task := govcd.NewTask(&c.Vmware.Client)
+	task.Task = media.Media.Tasks.Task[0]
+
+	return task, nil
+}
+
+// GetVMsWithInsertedMedia returns the VMs of the organization in which the media is inserted.
+// The query service does not expose the media inserted in a VM, the media sections are read from the
+// vApps, which contain the sections of their VMs: one request is sent per vApp instead of per VM.
+func (c *CloudAvenue) GetVMsWithInsertedMedia(mediaHREF string) ([]*govcd.VM, error) {
+	org, err := c.GetOrg()
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := org.QueryVmList(govcdtypes.VmQueryFilterOnlyDeployed)
+	if err != nil {
+		return nil, err
+	}
+
+	vms := make([]*govcd.VM, 0)
+	vAppHREFs := make(map[string]bool)
+	for _, record := range records {
+		if record.ContainerID == "" || vAppHREFs[record.ContainerID] {
+			continue
+		}
+		vAppHREFs[record.ContainerID] = true
+
+		vApp := govcd.NewVApp(&c.Vmware.Client)
+		vApp.VApp.HREF = record.ContainerID
+		if err := vApp.Refresh(); err != nil {
+			return nil, err
+		}
+
+		if vApp.VApp.Children == nil {
+			continue
+		}
+
+		for _, child := range vApp.VApp.Children.VM {
+			if !isMediaInserted(child, mediaHREF) {
+				continue
+			}
+
+			vm, err := c.Vmware.Client.GetVMByHref(child.HREF)
+			if err != nil {
+				return nil, err
+			}
+			vms = append(vms, vm)
+		}
+	}
+
+	return vms, nil
+}
+
+// isMediaInserted returns true if the media is inserted in the VM.
+func isMediaInserted(vm *govcdtypes.Vm, mediaHREF string) bool {
+	if vm == nil || vm.VmSpecSection == nil || vm.VmSpecSection.MediaSection == nil {
+		return false
+	}
+
+	for _, mediaSettings := range vm.VmSpecSection.MediaSection.MediaSettings {
+		if mediaSettings != nil && mediaSettings.MediaImage != nil && mediaSettings.MediaImage.HREF == mediaHREF {
+			return true
+		}
+	}
+
+	return false
+}
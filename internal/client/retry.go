@@ -0,0 +1,234 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+const (
+	// DefaultMaxRetries is the default number of retries of a request failing with a transient error.
+	DefaultMaxRetries = 5
+	// DefaultRetryMaxWait is the default maximum wait between two retries.
+	DefaultRetryMaxWait = 30 * time.Second
+
+	// retryMinWait is the wait before the first retry, doubled at each retry.
+	retryMinWait = 1 * time.Second
+	// retryMaxErrorBody is the maximum size of an error response read to classify it.
+	retryMaxErrorBody = 1 << 20
+	// retryMaxBufferedBody is the maximum size of a request body without GetBody buffered to be sent again.
+	// The larger bodies, e.g. the chunks of the uploads, are sent without retry.
+	retryMaxBufferedBody = 1 << 20
+)
+
+// transientErrors matches the error messages of the vCD and Cloud Avenue APIs returned
+// when an object is busy. The request can be retried once the running task is done.
+var transientErrors = regexp.MustCompile(`(?i)(BUSY_ENTITY|entity is busy|is busy completing an operation|another task is (already )?running|another operation is (already )?(running|in progress))`)
+
+// RetryPolicy defines how the requests failing with a transient error are retried.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries of a request. 0 disables the retries.
+	MaxRetries int
+	// MaxWait is the maximum wait between two retries, including the wait requested by the API with Retry-After.
+	MaxWait time.Duration
+	// LogContext is used to log the retries of the requests sent without a context, like the requests of govcd.
+	LogContext context.Context
+}
+
+// retryTransport is a http.RoundTripper retrying the requests failing with a transient error,
+// with an exponential backoff and jitter.
+type retryTransport struct {
+	next   http.RoundTripper
+	policy RetryPolicy
+}
+
+// newRetryTransport returns a http.RoundTripper retrying the requests sent with next.
+func newRetryTransport(next http.RoundTripper, policy RetryPolicy) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if policy.MaxWait <= 0 {
+		policy.MaxWait = DefaultRetryMaxWait
+	}
+
+	return &retryTransport{
+		next:   next,
+		policy: policy,
+	}
+}
+
+// withRetry returns a govcd option retrying the requests of the vCD client.
+func withRetry(policy RetryPolicy) govcd.VCDClientOption {
+	return func(vcdClient *govcd.VCDClient) error {
+		vcdClient.Client.Http.Transport = newRetryTransport(vcdClient.Client.Http.Transport, policy)
+		return nil
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.policy.MaxRetries <= 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	// The body is sent again on each retry.
+	getBody := req.GetBody
+	if req.Body != nil && req.Body != http.NoBody && getBody == nil {
+		if req.ContentLength <= 0 || req.ContentLength > retryMaxBufferedBody {
+			// The body has an unknown or a large size, it is not buffered and the request is not retried.
+			return t.next.RoundTrip(req)
+		}
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		if err := req.Body.Close(); err != nil {
+			return nil, err
+		}
+		getBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		// The request of the caller must not be modified, each attempt is sent with a copy.
+		attemptReq := req.Clone(req.Context())
+		if getBody != nil {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if err != nil || attempt >= t.policy.MaxRetries || !isTransientError(req, resp) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		tflog.Warn(t.logContext(req), "Request failed with a transient error, retrying", map[string]interface{}{
+			"method":      req.Method,
+			"url":         req.URL.Redacted(),
+			"status_code": resp.StatusCode,
+			"wait":        wait.String(),
+			"attempt":     attempt + 1,
+			"max_retries": t.policy.MaxRetries,
+		})
+
+		// The response is discarded before the retry.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// logContext returns the context used to log the events of a request.
+// The context of the request only holds the Terraform logger if the request is sent with the context of the operation.
+func (t *retryTransport) logContext(req *http.Request) context.Context {
+	if req.Context() == context.Background() && t.policy.LogContext != nil {
+		return t.policy.LogContext
+	}
+	return req.Context()
+}
+
+// backoff returns the wait before the next retry. The wait requested by the API
+// with Retry-After takes precedence over the exponential backoff.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		if wait > t.policy.MaxWait {
+			return t.policy.MaxWait
+		}
+		return wait
+	}
+
+	wait := retryMinWait << attempt
+	if wait <= 0 || wait > t.policy.MaxWait {
+		wait = t.policy.MaxWait
+	}
+	if wait < 2 {
+		return wait
+	}
+
+	// The jitter spreads the retries of the concurrent requests.
+	//nolint:gosec // The jitter does not need a cryptographically secure random number.
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)))
+}
+
+// parseRetryAfter parses the Retry-After header, in seconds or as a HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isTransientError returns true if the request failed with an error which can be retried.
+// A request which is not idempotent is only retried if it has been rejected before being applied:
+// HTTP 429, HTTP 503 with Retry-After, or a busy error.
+// The body of the error responses is read to find the busy errors and is restored.
+// helpers.CheckAPIError is not used: it classifies the errors decoded by the SDK, which do not
+// exist yet at the transport level, and the helpers package imports this package.
+func isTransientError(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		if isIdempotent(req.Method) || resp.Header.Get("Retry-After") != "" {
+			return true
+		}
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		// A request changing an object may have been applied before failing.
+		if isIdempotent(req.Method) {
+			return true
+		}
+	}
+
+	if resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return false
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, retryMaxErrorBody))
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	return transientErrors.Match(body)
+}
+
+// isIdempotent returns true if the request can be sent several times with the same effect.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,248 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		method     string
+		responses  []int
+		body       string
+		retryAfter bool
+		// withoutGetBody sends the request body without GetBody, with a known or an unknown length.
+		withoutGetBody bool
+		unknownLength  bool
+		// wantCalls is the number of requests received by the server.
+		wantCalls  int
+		wantStatus int
+	}{
+		{
+			name:       "TooManyRequests",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusTooManyRequests, http.StatusOK},
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "ServiceUnavailableWithRetryAfterOnPost",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusServiceUnavailable, http.StatusOK},
+			retryAfter: true,
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "ServiceUnavailableOnPost",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantCalls:  1,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "GatewayTimeoutOnPost",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusGatewayTimeout, http.StatusOK},
+			retryAfter: true,
+			wantCalls:  1,
+			wantStatus: http.StatusGatewayTimeout,
+		},
+		{
+			name:       "GatewayTimeoutOnDelete",
+			method:     http.MethodDelete,
+			responses:  []int{http.StatusGatewayTimeout, http.StatusServiceUnavailable, http.StatusOK},
+			wantCalls:  3,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "MaxRetries",
+			method:     http.MethodGet,
+			responses:  []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			wantCalls:  3,
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:       "BusyEntity",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusBadRequest, http.StatusOK},
+			body:       `<Error minorErrorCode="BUSY_ENTITY" message="The entity vm-1 is busy completing an operation."/>`,
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "InternalServerErrorOnGet",
+			method:     http.MethodGet,
+			responses:  []int{http.StatusInternalServerError, http.StatusOK},
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "InternalServerErrorOnPost",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusInternalServerError, http.StatusOK},
+			wantCalls:  1,
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "BadRequest",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusBadRequest, http.StatusOK},
+			body:       `{"code":"400","reason":"Bad Request","message":"invalid name"}`,
+			wantCalls:  1,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "BodyWithoutGetBody",
+			method:         http.MethodPut,
+			responses:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			withoutGetBody: true,
+			wantCalls:      2,
+			wantStatus:     http.StatusOK,
+		},
+		{
+			name:           "BodyWithUnknownLength",
+			method:         http.MethodPut,
+			responses:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			withoutGetBody: true,
+			unknownLength:  true,
+			wantCalls:      1,
+			wantStatus:     http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// The body is sent again on each retry
+				if body, _ := io.ReadAll(r.Body); string(body) != "payload" {
+					t.Errorf("call %d: got body %q, want %q", calls, body, "payload")
+				}
+
+				status := tt.responses[calls]
+				calls++
+				if status != http.StatusOK {
+					if tt.retryAfter {
+						w.Header().Set("Retry-After", "1")
+					}
+					w.WriteHeader(status)
+					_, _ = io.WriteString(w, tt.body)
+				}
+			}))
+			defer server.Close()
+
+			c := &http.Client{
+				Transport: newRetryTransport(http.DefaultTransport, RetryPolicy{MaxRetries: 2, MaxWait: 10 * time.Millisecond}),
+			}
+
+			req, err := http.NewRequestWithContext(context.Background(), tt.method, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.withoutGetBody {
+				req.GetBody = nil
+				req.Body = io.NopCloser(req.Body)
+			}
+			if tt.unknownLength {
+				req.ContentLength = -1
+			}
+
+			resp, err := c.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", calls, tt.wantCalls)
+			}
+			// The body of the error response is still readable
+			if body, _ := io.ReadAll(resp.Body); tt.wantStatus != http.StatusOK && string(body) != tt.body {
+				t.Errorf("got body %q, want %q", body, tt.body)
+			}
+		})
+	}
+}
+
+func TestRetryTransportContextDone(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := &http.Client{
+		Transport: newRetryTransport(http.DefaultTransport, RetryPolicy{MaxRetries: 5, MaxWait: time.Minute}),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	resp, err := c.Do(req)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("got no error, want the context error")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("got the error after %s, want the retries cancelled with the context", elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	if wait, ok := parseRetryAfter("3"); !ok || wait != 3*time.Second {
+		t.Errorf("seconds: got %s %t, want 3s", wait, ok)
+	}
+	if wait, ok := parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)); !ok || wait < 59*time.Minute {
+		t.Errorf("date: got %s %t, want about 1h", wait, ok)
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("invalid: got ok, want not ok")
+	}
+}
+
+func TestRetryTransportLogContext(t *testing.T) {
+	t.Parallel()
+
+	type key struct{}
+	logCtx := context.WithValue(context.Background(), key{}, "provider")
+	reqCtx := context.WithValue(context.Background(), key{}, "operation")
+	transport := &retryTransport{policy: RetryPolicy{LogContext: logCtx}}
+
+	// The requests of govcd are sent without a context.
+	req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := transport.logContext(req).Value(key{}); got != "provider" {
+		t.Errorf("request without a context: got %v, want the log context", got)
+	}
+
+	req = req.WithContext(reqCtx)
+	if got := transport.logContext(req).Value(key{}); got != "operation" {
+		t.Errorf("request with a context: got %v, want the context of the request", got)
+	}
+}
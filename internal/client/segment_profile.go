@@ -0,0 +1,155 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// ErrSegmentProfileNotSupported is returned when the VCD API does not support the segment profiles.
+var ErrSegmentProfileNotSupported = errors.New("segment profiles require VCD API version 36.2 or above")
+
+const (
+	segmentProfileMinAPIVersion = "36.2"
+
+	endpointOrgVdcNetworkSegmentProfiles = "1.0.0/orgVdcNetworks/%s/segmentProfiles"
+	endpointSegmentProfileTemplates      = "1.0.0/segmentProfileTemplates"
+	endpointSegmentProfiles              = "1.0.0/infra/segmentProfiles/"
+)
+
+// SegmentProfileType is the type of an NSX-T segment profile.
+type SegmentProfileType string
+
+const (
+	SegmentProfileTypeIPDiscovery     SegmentProfileType = "ipDiscoveryProfiles"
+	SegmentProfileTypeMACDiscovery    SegmentProfileType = "macDiscoveryProfiles"
+	SegmentProfileTypeSpoofGuard      SegmentProfileType = "spoofGuardProfiles"
+	SegmentProfileTypeQoS             SegmentProfileType = "qosProfiles"
+	SegmentProfileTypeSegmentSecurity SegmentProfileType = "segmentSecurityProfiles"
+)
+
+// OrgVDCNetworkSegmentProfiles contains the segment profiles applied to an Org VDC network.
+type OrgVDCNetworkSegmentProfiles struct {
+	SegmentProfileTemplate *OrgVDCNetworkSegmentProfileTemplateRef `json:"segmentProfileTemplate,omitempty"`
+	IPDiscoveryProfile     *govcdtypes.OpenApiReference            `json:"ipDiscoveryProfile"`
+	MACDiscoveryProfile    *govcdtypes.OpenApiReference            `json:"macDiscoveryProfile"`
+	SpoofGuardProfile      *govcdtypes.OpenApiReference            `json:"spoofGuardProfile"`
+	QoSProfile             *govcdtypes.OpenApiReference            `json:"qosProfile"`
+	SegmentSecurityProfile *govcdtypes.OpenApiReference            `json:"segmentSecurityProfile"`
+}
+
+// OrgVDCNetworkSegmentProfileTemplateRef is the segment profile template applied to an Org VDC network.
+type OrgVDCNetworkSegmentProfileTemplateRef struct {
+	// Source is the level where the template is defined (ORG_VDC_NETWORK, ORG_VDC or GLOBAL).
+	Source      string                       `json:"source,omitempty"`
+	TemplateRef *govcdtypes.OpenApiReference `json:"templateRef"`
+}
+
+// SegmentProfileTemplate is an NSX-T segment profile template.
+type SegmentProfileTemplate struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// SegmentProfile is an NSX-T segment profile.
+type SegmentProfile struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// segmentProfileAPIVersion returns the API version used by the segment profile endpoints.
+func (c *CloudAvenue) segmentProfileAPIVersion() (string, error) {
+	if !c.Vmware.Client.APIClientVersionIs(">= " + segmentProfileMinAPIVersion) {
+		return "", ErrSegmentProfileNotSupported
+	}
+	return segmentProfileMinAPIVersion, nil
+}
+
+// GetOrgVDCNetworkSegmentProfiles returns the segment profiles applied to an Org VDC network.
+func (c *CloudAvenue) GetOrgVDCNetworkSegmentProfiles(orgNetworkID string) (*OrgVDCNetworkSegmentProfiles, error) {
+	apiVersion, err := c.segmentProfileAPIVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	urlRef, err := c.Vmware.Client.OpenApiBuildEndpoint(fmt.Sprintf(endpointOrgVdcNetworkSegmentProfiles, orgNetworkID))
+	if err != nil {
+		return nil, err
+	}
+
+	segmentProfiles := &OrgVDCNetworkSegmentProfiles{}
+	if err := c.Vmware.Client.OpenApiGetItem(apiVersion, urlRef, nil, segmentProfiles, nil); err != nil {
+		return nil, err
+	}
+
+	return segmentProfiles, nil
+}
+
+// UpdateOrgVDCNetworkSegmentProfiles updates the segment profiles applied to an Org VDC network.
+func (c *CloudAvenue) UpdateOrgVDCNetworkSegmentProfiles(orgNetworkID string, segmentProfiles *OrgVDCNetworkSegmentProfiles) (*OrgVDCNetworkSegmentProfiles, error) {
+	apiVersion, err := c.segmentProfileAPIVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	urlRef, err := c.Vmware.Client.OpenApiBuildEndpoint(fmt.Sprintf(endpointOrgVdcNetworkSegmentProfiles, orgNetworkID))
+	if err != nil {
+		return nil, err
+	}
+
+	updated := &OrgVDCNetworkSegmentProfiles{}
+	if err := c.Vmware.Client.OpenApiPutItem(apiVersion, urlRef, nil, segmentProfiles, updated, nil); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// GetAllSegmentProfileTemplates returns the segment profile templates available in the organization.
+func (c *CloudAvenue) GetAllSegmentProfileTemplates() ([]*SegmentProfileTemplate, error) {
+	apiVersion, err := c.segmentProfileAPIVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	urlRef, err := c.Vmware.Client.OpenApiBuildEndpoint(endpointSegmentProfileTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*SegmentProfileTemplate, 0)
+	if err := c.Vmware.Client.OpenApiGetAllItems(apiVersion, urlRef, nil, &templates, nil); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// GetAllSegmentProfiles returns the segment profiles of the given type available in a VDC or a VDC Group.
+func (c *CloudAvenue) GetAllSegmentProfiles(profileType SegmentProfileType, vdcOrVDCGroup VDCOrVDCGroupHandler) ([]*SegmentProfile, error) {
+	apiVersion, err := c.segmentProfileAPIVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	urlRef, err := c.Vmware.Client.OpenApiBuildEndpoint(endpointSegmentProfiles, string(profileType))
+	if err != nil {
+		return nil, err
+	}
+
+	filter := "orgVdcId==" + vdcOrVDCGroup.GetID()
+	if vdcOrVDCGroup.IsVDCGroup() {
+		filter = "vdcGroupId==" + vdcOrVDCGroup.GetID()
+	}
+
+	profiles := make([]*SegmentProfile, 0)
+	if err := c.Vmware.Client.OpenApiGetAllItems(apiVersion, urlRef, url.Values{"filter": []string{filter}}, &profiles, nil); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// DefaultHTTPTimeout is the default timeout of a request, the default of the Vmware client.
+const DefaultHTTPTimeout = 600 * time.Second
+
+var (
+	// ErrConfigureTransport is returned when the configuration of the HTTP transport failed.
+	ErrConfigureTransport = errors.New("error configuring HTTP transport")
+	// ErrInvalidCA is returned when the CA bundle does not contain any PEM certificate.
+	ErrInvalidCA = errors.New("no PEM certificate found in the CA bundle")
+)
+
+// createTransport creates the HTTP transport shared by the CloudAvenue and the Vmware clients,
+// with the proxy and the TLS settings of the client.
+func (c *CloudAvenue) createTransport() (*http.Transport, error) {
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("unexpected default transport type %T", http.DefaultTransport)
+	}
+	transport = transport.Clone()
+
+	// Proxy
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: scheme and host are required", proxyURL.Redacted())
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	// TLS
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		//nolint:gosec // Disabling the verification is an explicit choice of the user.
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	bundles := make([][]byte, 0, 2)
+	if c.CAFile != "" {
+		data, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the CA bundle: %w", err)
+		}
+		bundles = append(bundles, data)
+	}
+	if c.CAPEM != "" {
+		bundles = append(bundles, []byte(c.CAPEM))
+	}
+	if len(bundles) > 0 {
+		// The CA bundles are trusted in addition to the system certificates.
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		for _, bundle := range bundles {
+			if !pool.AppendCertsFromPEM(bundle) {
+				return nil, ErrInvalidCA
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// createHTTPClient creates the HTTP client of the CloudAvenue client, retrying the requests failing with a transient error.
+func (c *CloudAvenue) createHTTPClient() *http.Client {
+	var transport http.RoundTripper = http.DefaultTransport
+	if c.transport != nil {
+		transport = c.transport
+	}
+
+	return &http.Client{
+		Transport: newRetryTransport(transport, c.retryPolicy()),
+		Timeout:   c.HTTPTimeout,
+	}
+}
+
+// withTransport returns a govcd option setting the HTTP transport and timeout of the Vmware client.
+func withTransport(transport *http.Transport, timeout time.Duration) govcd.VCDClientOption {
+	return func(vcdClient *govcd.VCDClient) error {
+		if transport != nil {
+			vcdClient.Client.Http.Transport = transport
+		}
+		if timeout > 0 {
+			vcdClient.Client.Http.Timeout = timeout
+		}
+		return nil
+	}
+}
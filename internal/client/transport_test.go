@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func get(t *testing.T, ca *CloudAvenue, target string) (*http.Response, error) {
+	t.Helper()
+
+	transport, err := ca.createTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca.transport = transport
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, target, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := ca.createHTTPClient().Do(req)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return resp, err
+}
+
+func TestTransportTLS(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(caPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := get(t, &CloudAvenue{}, server.URL); err == nil {
+		t.Error("unknown CA: got no error, want a certificate error")
+	}
+	if _, err := get(t, &CloudAvenue{CAPEM: caPEM}, server.URL); err != nil {
+		t.Errorf("ca_pem: %s", err)
+	}
+	if _, err := get(t, &CloudAvenue{CAFile: caFile}, server.URL); err != nil {
+		t.Errorf("ca_file: %s", err)
+	}
+	if _, err := get(t, &CloudAvenue{Insecure: true}, server.URL); err != nil {
+		t.Errorf("insecure: %s", err)
+	}
+
+	if _, err := (&CloudAvenue{CAPEM: "not a certificate"}).createTransport(); !errors.Is(err, ErrInvalidCA) {
+		t.Errorf("invalid ca_pem: got %v, want %s", err, ErrInvalidCA)
+	}
+	if _, err := (&CloudAvenue{CAFile: filepath.Join(t.TempDir(), "missing.pem")}).createTransport(); err == nil {
+		t.Error("missing ca_file: got no error")
+	}
+}
+
+func TestTransportProxy(t *testing.T) {
+	t.Parallel()
+
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	if _, err := get(t, &CloudAvenue{ProxyURL: proxy.URL}, "http://console.cloudavenue.invalid/api/versions"); err != nil {
+		t.Fatal(err)
+	}
+	if proxied != "http://console.cloudavenue.invalid/api/versions" {
+		t.Errorf("got proxied request %q, want the request to the API", proxied)
+	}
+
+	if _, err := (&CloudAvenue{ProxyURL: "proxy.example.com"}).createTransport(); err == nil {
+		t.Error("invalid proxy_url: got no error")
+	}
+}
+
+func TestWithTransport(t *testing.T) {
+	t.Parallel()
+
+	ca := &CloudAvenue{Insecure: true, HTTPTimeout: 42 * time.Second}
+	transport, err := ca.createTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vcdClient := govcd.NewVCDClient(url.URL{Scheme: "https", Host: "console.cloudavenue.invalid", Path: "/api"}, ca.Insecure, withTransport(transport, ca.HTTPTimeout))
+
+	if vcdClient.Client.Http.Transport != transport {
+		t.Errorf("got transport %v, want the transport of the client", vcdClient.Client.Http.Transport)
+	}
+	if vcdClient.Client.Http.Timeout != 42*time.Second {
+		t.Errorf("got timeout %s, want 42s", vcdClient.Client.Http.Timeout)
+	}
+}
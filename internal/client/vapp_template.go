@@ -0,0 +1,83 @@
+package client
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// ErrVAppTemplateCaptureTaskNotFound is returned when the captured vApp Template does not expose the capture task.
+var ErrVAppTemplateCaptureTaskNotFound = errors.New("vApp Template capture task not found")
+
+const mimeCaptureVAppParams = "application/vnd.vmware.vcloud.captureVAppParams+xml"
+
+// captureVAppParams is the XML body of the request capturing a vApp as a vApp Template in a catalog.
+type captureVAppParams struct {
+	XMLName              xml.Name                        `xml:"CaptureVAppParams"`
+	Xmlns                string                          `xml:"xmlns,attr"`
+	XmlnsOvf             string                          `xml:"xmlns:ovf,attr"`
+	Name                 string                          `xml:"name,attr"`
+	Description          string                          `xml:"Description,omitempty"`
+	Source               *govcdtypes.Reference           `xml:"Source"`
+	CustomizationSection captureVAppCustomizationSection `xml:"CustomizationSection"`
+	TargetCatalogItem    *govcdtypes.Reference           `xml:"TargetCatalogItem,omitempty"`
+}
+
+type captureVAppCustomizationSection struct {
+	Info                   string `xml:"ovf:Info"`
+	CustomizeOnInstantiate bool   `xml:"CustomizeOnInstantiate"`
+}
+
+// CaptureVAppParams defines how a vApp is captured as a vApp Template.
+type CaptureVAppParams struct {
+	// Name of the vApp Template.
+	Name string
+	// Description of the vApp Template.
+	Description string
+	// CustomizeOnInstantiate enables the guest customization of the VMs instantiated from the vApp Template.
+	CustomizeOnInstantiate bool
+	// TargetCatalogItem is the catalog item overwritten by the capture. If nil, a new catalog item is created.
+	TargetCatalogItem *govcd.CatalogItem
+}
+
+// CaptureVApp captures a vApp as a vApp Template in a catalog and returns the capture task.
+// govcd does not expose the captureVApp action of a catalog.
+func (c *CloudAvenue) CaptureVApp(catalog *govcd.Catalog, vApp *govcd.VApp, params CaptureVAppParams) (*govcd.Task, error) {
+	body := &captureVAppParams{
+		Xmlns:       govcdtypes.XMLNamespaceVCloud,
+		XmlnsOvf:    govcdtypes.XMLNamespaceOVF,
+		Name:        params.Name,
+		Description: params.Description,
+		Source: &govcdtypes.Reference{
+			HREF: vApp.VApp.HREF,
+		},
+		CustomizationSection: captureVAppCustomizationSection{
+			Info:                   "VApp template customization section",
+			CustomizeOnInstantiate: params.CustomizeOnInstantiate,
+		},
+	}
+
+	if params.TargetCatalogItem != nil {
+		body.TargetCatalogItem = &govcdtypes.Reference{
+			HREF: params.TargetCatalogItem.CatalogItem.HREF,
+		}
+	}
+
+	vAppTemplate := &govcdtypes.VAppTemplate{}
+	if _, err := c.Vmware.Client.ExecuteRequest(catalog.Catalog.HREF+"/action/captureVApp", http.MethodPost, mimeCaptureVAppParams, "error capturing vApp: %s", body, vAppTemplate); err != nil {
+		return nil, err
+	}
+
+	if vAppTemplate.Tasks == nil || len(vAppTemplate.Tasks.Task) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrVAppTemplateCaptureTaskNotFound, params.Name)
+	}
+
+	task := govcd.NewTask(&c.Vmware.Client)
+	task.Task = vAppTemplate.Tasks.Task[0]
+
+	return task, nil
+}
@@ -253,6 +253,78 @@ func (c *CloudAvenue) GetVDCGroup(vdcGroupName string) (*VDCGroup, error) {
 	return &VDCGroup{x}, nil
 }
 
+// GetVDCGroupByNameOrID return the vdc group using the name or the ID provided in the argument.
+func (c *CloudAvenue) GetVDCGroupByNameOrID(vdcGroupNameOrID string) (*VDCGroup, error) {
+	if !uuid.IsVDCGroup(vdcGroupNameOrID) {
+		return c.GetVDCGroup(vdcGroupNameOrID)
+	}
+
+	adminOrg, err := c.Vmware.GetAdminOrgByName(c.GetOrgName())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRetrievingOrgAdmin, err)
+	}
+
+	x, err := adminOrg.GetVdcGroupById(vdcGroupNameOrID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s %w", ErrRetrievingVDCGroup, vdcGroupNameOrID, err)
+	}
+
+	return &VDCGroup{x}, nil
+}
+
+// CreateVDCGroup create a new NSX-T vdc group with the VDCs provided in the argument.
+// groupType is `LOCAL` or `UNIVERSAL`.
+func (c *CloudAvenue) CreateVDCGroup(name, description, groupType string, vdcIDs []string) (*VDCGroup, error) {
+	if len(vdcIDs) == 0 {
+		return nil, fmt.Errorf("%w", ErrEmptyVDCNameProvided)
+	}
+
+	adminOrg, err := c.Vmware.GetAdminOrgByName(c.GetOrgName())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRetrievingOrgAdmin, err)
+	}
+
+	candidateVdcs, err := adminOrg.GetAllNsxtVdcGroupCandidates(vdcIDs[0], nil)
+	if err != nil {
+		return nil, err
+	}
+
+	participatingOrgVdcs := make([]govcdtypes.ParticipatingOrgVdcs, 0, len(vdcIDs))
+	for _, vdcID := range vdcIDs {
+		found := false
+		for _, candidateVdc := range candidateVdcs {
+			if candidateVdc.Id == vdcID {
+				participatingOrgVdcs = append(participatingOrgVdcs, govcdtypes.ParticipatingOrgVdcs{
+					VdcRef:               govcdtypes.OpenApiReference{ID: candidateVdc.Id},
+					SiteRef:              candidateVdc.SiteRef,
+					OrgRef:               candidateVdc.OrgRef,
+					FaultDomainTag:       candidateVdc.FaultDomainTag,
+					NetworkProviderScope: candidateVdc.NetworkProviderScope,
+				})
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("VDC %s is not a candidate for the VDC Group %s", vdcID, name)
+		}
+	}
+
+	x, err := adminOrg.CreateVdcGroup(&govcdtypes.VdcGroup{
+		OrgId:                adminOrg.AdminOrg.ID,
+		Name:                 name,
+		Description:          description,
+		ParticipatingOrgVdcs: participatingOrgVdcs,
+		NetworkProviderType:  "NSX_T",
+		Type:                 groupType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &VDCGroup{x}, nil
+}
+
 // GetVDCOrVDCGroup return the vdc or vdc group using the name provided in the argument.
 func (c *CloudAvenue) GetVDCOrVDCGroup(vdcOrVDCGroupName string) (VDCOrVDCGroupHandler, error) {
 	x, err := c.GetVDC(
@@ -1,6 +1,9 @@
 package client
 
 import (
+	"net/http"
+	"net/url"
+
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 )
@@ -9,6 +12,18 @@ type VM struct {
 	*govcd.VM
 }
 
+// SuspendVM suspends a VM. The VM is resumed by the next power on.
+// govcd only suspends a whole vApp.
+func (c *CloudAvenue) SuspendVM(vm *govcd.VM) (govcd.Task, error) {
+	apiEndpoint, err := url.ParseRequestURI(vm.VM.HREF)
+	if err != nil {
+		return govcd.Task{}, err
+	}
+	apiEndpoint.Path += "/power/action/suspend"
+
+	return c.Vmware.Client.ExecuteTaskRequest(apiEndpoint.String(), http.MethodPost, "", "error suspending VM: %s", nil)
+}
+
 // * Guest properties
 // GetGuestProperties returns the guest properties of a VM.
 func (v VM) GetGuestProperties() (guestProperties []*govcdtypes.Property, err error) {
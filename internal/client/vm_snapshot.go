@@ -0,0 +1,106 @@
+package client
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// ErrVMSnapshotLinkNotFound is returned when the VM does not expose the snapshot link (e.g. no snapshot to revert).
+var ErrVMSnapshotLinkNotFound = errors.New("snapshot link not found")
+
+const mimeCreateSnapshotParams = "application/vnd.vmware.vcloud.createSnapshotParams+xml"
+
+// VMSnapshotParams is the parameters used to create a VM snapshot.
+type VMSnapshotParams struct {
+	Description string
+	// Memory defines if the memory of the VM is included in the snapshot.
+	Memory bool
+	// Quiesce defines if the file system of the VM is quiesced before the snapshot (requires VMware Tools).
+	Quiesce bool
+}
+
+// createSnapshotParams is the XML body of the snapshot:create request.
+type createSnapshotParams struct {
+	XMLName     xml.Name `xml:"CreateSnapshotParams"`
+	Xmlns       string   `xml:"xmlns,attr"`
+	Memory      bool     `xml:"memory,attr"`
+	Quiesce     bool     `xml:"quiesce,attr"`
+	Description string   `xml:"Description,omitempty"`
+}
+
+// GetSnapshot returns the snapshot of a VM or nil if the VM has no snapshot.
+// A VM has at most one snapshot.
+func (v VM) GetSnapshot() *govcdtypes.SnapshotItem {
+	if v.VM.VM.Snapshots == nil || len(v.VM.VM.Snapshots.Snapshot) == 0 {
+		return nil
+	}
+
+	return v.VM.VM.Snapshots.Snapshot[0]
+}
+
+// snapshotLink returns the snapshot link of a VM for the given rel.
+func (v VM) snapshotLink(rel string) (*govcdtypes.Link, error) {
+	for _, link := range v.VM.VM.Link {
+		if link != nil && link.Rel == rel {
+			return link, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrVMSnapshotLinkNotFound, rel)
+}
+
+// CreateVMSnapshot creates a snapshot of a VM. The existing snapshot of the VM is replaced.
+func (c *CloudAvenue) CreateVMSnapshot(v VM, params VMSnapshotParams) error {
+	link, err := v.snapshotLink(govcdtypes.RelSnapshotCreate)
+	if err != nil {
+		return err
+	}
+
+	task, err := c.Vmware.Client.ExecuteTaskRequest(link.HREF, http.MethodPost, mimeCreateSnapshotParams, "error creating VM snapshot: %s", &createSnapshotParams{
+		Xmlns:       govcdtypes.XMLNamespaceVCloud,
+		Memory:      params.Memory,
+		Quiesce:     params.Quiesce,
+		Description: params.Description,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := task.WaitTaskCompletion(); err != nil {
+		return err
+	}
+
+	return v.Refresh()
+}
+
+// RevertVMSnapshot reverts a VM to its current snapshot.
+func (c *CloudAvenue) RevertVMSnapshot(v VM) error {
+	return c.executeVMSnapshotAction(v, govcdtypes.RelSnapshotRevertToCurrent, "error reverting VM snapshot: %s")
+}
+
+// RemoveVMSnapshots removes all the snapshots of a VM.
+func (c *CloudAvenue) RemoveVMSnapshots(v VM) error {
+	return c.executeVMSnapshotAction(v, govcdtypes.RelSnapshotRemoveAll, "error removing VM snapshot: %s")
+}
+
+func (c *CloudAvenue) executeVMSnapshotAction(v VM, rel, errorMessage string) error {
+	link, err := v.snapshotLink(rel)
+	if err != nil {
+		return err
+	}
+
+	task, err := c.Vmware.Client.ExecuteTaskRequest(link.HREF, http.MethodPost, "", errorMessage, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := task.WaitTaskCompletion(); err != nil {
+		return err
+	}
+
+	return v.Refresh()
+}
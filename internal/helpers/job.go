@@ -3,12 +3,45 @@ package helpers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	apiclient "github.com/orange-cloudavenue/cloudavenue-sdk-go"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 )
 
+var (
+	// ErrJobStillRunning is returned when a job is not done before the timeout expires or the context is canceled.
+	ErrJobStillRunning = errors.New("job still running")
+	// ErrJobFailed is returned when a job has failed.
+	ErrJobFailed = errors.New("job failed")
+
+	// errJobNotDone is retried until the job is done. It is returned by retry.RetryContext when the timeout expires.
+	errJobNotDone = errors.New("job not done")
+)
+
+// privateStateKeyPendingJob is the key of the private state storing the ID of a job not yet done.
+const privateStateKeyPendingJob = "pending_job_id"
+
+// PendingJobNote is the note of the resources resuming their creation job with ResumePendingJob.
+const PendingJobNote = "~> **Note:** If the creation job is still running when the create timeout expires, the apply succeeds with a warning and the job is resumed on the next refresh."
+
+// PrivateState is the private state of a resource (req.Private and resp.Private of the resource methods).
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
 // JobStatusMessage is a type for job status.
 type JobStatusMessage string
 
@@ -32,25 +65,39 @@ func GetJobStatus(
 	ctx context.Context,
 	client *client.CloudAvenue,
 	jobID string,
-) (JobStatusMessage, error) {
+) (status JobStatusMessage, err error) {
 	jobStatus, httpR, err := client.APIClient.JobsApi.GetJobById(ctx, jobID)
+	if httpR != nil {
+		defer func() {
+			err = errors.Join(err, httpR.Body.Close())
+		}()
+	}
 	if err != nil {
 		return "", err
 	}
-	defer func() {
-		err = errors.Join(err, httpR.Body.Close())
-	}()
+
+	return jobStatusFromResponse(jobID, jobStatus)
+}
+
+// jobStatusFromResponse returns the status of a job from the response of the API.
+// An error is returned with the failed action if the job has failed.
+func jobStatusFromResponse(jobID string, jobStatus []apiclient.JobStatus) (JobStatusMessage, error) {
+	if len(jobStatus) == 0 {
+		return "", fmt.Errorf("no status returned for the job %s", jobID)
+	}
+
+	status := parseJobStatus(jobStatus[0].Status)
 
 	// Find the action name with failed status if global status is failed
-	if jobStatus[0].Status == string(FAILED) {
+	if status.IsFailed() {
 		for _, action := range jobStatus[0].Actions {
-			if action.Status == string(FAILED) {
-				return parseJobStatus(jobStatus[0].Status), errors.New("Error in action : " + action.Name)
+			if parseJobStatus(action.Status).IsFailed() {
+				return status, errors.New("Error in action : " + action.Name)
 			}
 		}
 	}
 
-	return parseJobStatus(jobStatus[0].Status), nil
+	return status, nil
 }
 
 // parseJobStatus return the status of a job.
@@ -60,6 +107,8 @@ func parseJobStatus(str string) JobStatusMessage {
 		return DONE
 	case "FAILED":
 		return FAILED
+	case "ERROR":
+		return ERROR
 	case "CREATED":
 		return CREATED
 	case "PENDING":
@@ -81,6 +130,11 @@ func (j JobStatusMessage) IsDone() bool {
 	return j == DONE
 }
 
+// IsFailed is a helper function to check if a job has failed.
+func (j JobStatusMessage) IsFailed() bool {
+	return j == FAILED || j == ERROR
+}
+
 // JobStatePending is a helper function to return an array of pending states.
 func JobStatePending() []string {
 	return []string{CREATED.String(), INPROGRESS.String(), PENDING.String()}
@@ -90,3 +144,137 @@ func JobStatePending() []string {
 func JobStateDone() []string {
 	return []string{DONE.String()}
 }
+
+// WaitForJob waits for a job to be done.
+// It returns an error wrapping ErrJobStillRunning if the job is not done before the timeout expires
+// or the context is canceled, and an error wrapping ErrJobFailed if the job has failed.
+func WaitForJob(
+	ctx context.Context,
+	client *client.CloudAvenue,
+	jobID string,
+	timeout time.Duration,
+) error {
+	return waitForJob(ctx, jobID, timeout, func(ctx context.Context) (JobStatusMessage, error) {
+		return GetJobStatus(ctx, client, jobID)
+	})
+}
+
+// waitForJob waits for the job jobID to be done, getting its status with getJobStatus.
+func waitForJob(
+	ctx context.Context,
+	jobID string,
+	timeout time.Duration,
+	getJobStatus func(ctx context.Context) (JobStatusMessage, error),
+) error {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		jobStatus, errGetJob := getJobStatus(ctx)
+		if jobStatus.IsFailed() {
+			return retry.NonRetryableError(errors.Join(fmt.Errorf("%w: %s", ErrJobFailed, jobID), errGetJob))
+		}
+		if errGetJob != nil {
+			return retry.NonRetryableError(errGetJob)
+		}
+		if !slices.Contains(JobStateDone(), jobStatus.String()) {
+			return retry.RetryableError(fmt.Errorf("%w: expected job done but was %s", errJobNotDone, jobStatus))
+		}
+
+		return nil
+	})
+
+	var timeoutErr *retry.TimeoutError
+	if err != nil && !errors.Is(err, ErrJobFailed) && (ctx.Err() != nil || errors.Is(err, errJobNotDone) || errors.As(err, &timeoutErr)) {
+		return fmt.Errorf("%w: %s: %w", ErrJobStillRunning, jobID, err)
+	}
+
+	return err
+}
+
+// SetPendingJob stores in the private state of a resource the ID of a job not yet done.
+// The job can then be resumed on the next plan or apply with GetPendingJob.
+func SetPendingJob(ctx context.Context, private PrivateState, jobID string) diag.Diagnostics {
+	value, err := json.Marshal(jobID)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error storing the job ID in the private state", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, privateStateKeyPendingJob, value)
+}
+
+// GetPendingJob returns the ID of the job stored in the private state of a resource.
+// An empty string is returned if no job is pending.
+func GetPendingJob(ctx context.Context, private PrivateState) (string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, privateStateKeyPendingJob)
+	if diags.HasError() || len(value) == 0 {
+		return "", diags
+	}
+
+	var jobID string
+	if err := json.Unmarshal(value, &jobID); err != nil {
+		diags.AddError("Error reading the job ID from the private state", err.Error())
+	}
+
+	return jobID, diags
+}
+
+// ClearPendingJob removes the job ID stored in the private state of a resource.
+func ClearPendingJob(ctx context.Context, private PrivateState) diag.Diagnostics {
+	return SetPendingJob(ctx, private, "")
+}
+
+// ResumePendingJob waits for the job stored in the private state of a resource by SetPendingJob, if any.
+// The job is removed from the private state once done. It returns:
+//   - DONE if there is no pending job or once the job is done;
+//   - FAILED if the job has failed, the object has not been created;
+//   - INPROGRESS if the job is not done before the timeout expires, a warning is then added to the diagnostics.
+func ResumePendingJob(
+	ctx context.Context,
+	client *client.CloudAvenue,
+	private PrivateState,
+	timeout time.Duration,
+) (JobStatusMessage, diag.Diagnostics) {
+	return resumePendingJob(ctx, private, func(jobID string) error {
+		return WaitForJob(ctx, client, jobID, timeout)
+	})
+}
+
+// resumePendingJob waits with waitJob for the job stored in the private state, see ResumePendingJob.
+func resumePendingJob(
+	ctx context.Context,
+	private PrivateState,
+	waitJob func(jobID string) error,
+) (JobStatusMessage, diag.Diagnostics) {
+	jobID, diags := GetPendingJob(ctx, private)
+	if diags.HasError() || jobID == "" {
+		return DONE, diags
+	}
+
+	errJob := waitJob(jobID)
+	switch {
+	case errors.Is(errJob, ErrJobFailed):
+		return FAILED, diags
+	case errors.Is(errJob, ErrJobStillRunning):
+		diags.AddWarning(
+			"Job still running",
+			fmt.Sprintf("The job %s started by a previous apply is not done yet. It will be resumed on the next refresh.", jobID),
+		)
+		return INPROGRESS, diags
+	case errJob != nil:
+		diags.AddError("Error waiting job to complete", errJob.Error())
+		return "", diags
+	}
+
+	diags.Append(ClearPendingJob(ctx, private)...)
+	return DONE, diags
+}
+
+// NewJobStillRunningWarning returns the warning of a Create stopped by WaitForJob while the creation job is still running.
+// The job must be stored with SetPendingJob to be resumed on the next refresh.
+func NewJobStillRunningWarning(object, jobID string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Job still running",
+		fmt.Sprintf("The creation job %s of the %s is not done before the create timeout. "+
+			"It will be resumed on the next refresh, the resources depending on the %s may fail until then.", jobID, object, object),
+	)
+}
@@ -0,0 +1,185 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	apiclient "github.com/orange-cloudavenue/cloudavenue-sdk-go"
+)
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestPendingJob(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+
+	if jobID, diags := GetPendingJob(ctx, private); diags.HasError() || jobID != "" {
+		t.Errorf("GetPendingJob() without job: got %q, %v, want empty job ID", jobID, diags)
+	}
+
+	if diags := SetPendingJob(ctx, private, "b8d1c5c6-5fd2-4a3a-a4b1-1f7f7d2d6a4e"); diags.HasError() {
+		t.Fatalf("SetPendingJob(): %v", diags)
+	}
+
+	if jobID, diags := GetPendingJob(ctx, private); diags.HasError() || jobID != "b8d1c5c6-5fd2-4a3a-a4b1-1f7f7d2d6a4e" {
+		t.Errorf("GetPendingJob(): got %q, %v, want b8d1c5c6-5fd2-4a3a-a4b1-1f7f7d2d6a4e", jobID, diags)
+	}
+
+	if diags := ClearPendingJob(ctx, private); diags.HasError() {
+		t.Fatalf("ClearPendingJob(): %v", diags)
+	}
+
+	if string(private[privateStateKeyPendingJob]) != `""` {
+		t.Errorf("ClearPendingJob(): got %s, want a valid JSON empty string", private[privateStateKeyPendingJob])
+	}
+
+	if jobID, diags := GetPendingJob(ctx, private); diags.HasError() || jobID != "" {
+		t.Errorf("GetPendingJob() after ClearPendingJob(): got %q, %v, want empty job ID", jobID, diags)
+	}
+}
+
+func TestJobStatusFromResponse(t *testing.T) {
+	if _, err := jobStatusFromResponse("job-1", nil); err == nil {
+		t.Error("empty response: got no error")
+	}
+
+	status, err := jobStatusFromResponse("job-1", []apiclient.JobStatus{{Status: "DONE"}})
+	if err != nil || !status.IsDone() {
+		t.Errorf("DONE: got %s, %v, want done", status, err)
+	}
+
+	for _, s := range []string{"FAILED", "ERROR"} {
+		status, err = jobStatusFromResponse("job-1", []apiclient.JobStatus{{
+			Status:  s,
+			Actions: []apiclient.JobStatusActions{{Name: "create_edge", Status: s}},
+		}})
+		if !status.IsFailed() || err == nil {
+			t.Errorf("%s: got %s, %v, want failed with the failed action", s, status, err)
+		}
+	}
+}
+
+// jobStatuses returns a getJobStatus function returning the statuses in order, then the last one.
+func jobStatuses(statuses ...JobStatusMessage) func(ctx context.Context) (JobStatusMessage, error) {
+	i := 0
+	return func(ctx context.Context) (JobStatusMessage, error) {
+		status := statuses[i]
+		if i < len(statuses)-1 {
+			i++
+		}
+		return status, nil
+	}
+}
+
+func TestWaitForJob(t *testing.T) {
+	ctx := context.Background()
+
+	if err := waitForJob(ctx, "job-1", time.Minute, jobStatuses(INPROGRESS, DONE)); err != nil {
+		t.Errorf("done: got %v, want no error", err)
+	}
+
+	if err := waitForJob(ctx, "job-1", time.Minute, jobStatuses(INPROGRESS, ERROR)); !errors.Is(err, ErrJobFailed) {
+		t.Errorf("failed: got %v, want %s", err, ErrJobFailed)
+	}
+
+	if err := waitForJob(ctx, "job-1", time.Second, jobStatuses(INPROGRESS)); !errors.Is(err, ErrJobStillRunning) {
+		t.Errorf("timeout: got %v, want %s", err, ErrJobStillRunning)
+	}
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := waitForJob(canceledCtx, "job-1", time.Minute, jobStatuses(INPROGRESS)); !errors.Is(err, ErrJobStillRunning) {
+		t.Errorf("canceled: got %v, want %s", err, ErrJobStillRunning)
+	}
+
+	errAPI := errors.New("api error")
+	if err := waitForJob(ctx, "job-1", time.Minute, func(ctx context.Context) (JobStatusMessage, error) { return "", errAPI }); !errors.Is(err, errAPI) || errors.Is(err, ErrJobStillRunning) {
+		t.Errorf("api error: got %v, want %s", err, errAPI)
+	}
+}
+
+func TestResumePendingJob(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		jobID      string
+		errJob     error
+		wantStatus JobStatusMessage
+		// wantPending is true if the job is kept in the private state.
+		wantPending bool
+		wantWarning bool
+	}{
+		{
+			name:       "NoJob",
+			wantStatus: DONE,
+		},
+		{
+			name:       "Done",
+			jobID:      "job-1",
+			wantStatus: DONE,
+		},
+		{
+			name:        "Failed",
+			jobID:       "job-1",
+			errJob:      fmt.Errorf("%w: job-1", ErrJobFailed),
+			wantStatus:  FAILED,
+			wantPending: true,
+		},
+		{
+			name:        "StillRunning",
+			jobID:       "job-1",
+			errJob:      fmt.Errorf("%w: job-1", ErrJobStillRunning),
+			wantStatus:  INPROGRESS,
+			wantPending: true,
+			wantWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			private := testPrivateState{}
+			if tt.jobID != "" {
+				if diags := SetPendingJob(ctx, private, tt.jobID); diags.HasError() {
+					t.Fatalf("SetPendingJob(): %v", diags)
+				}
+			}
+
+			waited := ""
+			status, diags := resumePendingJob(ctx, private, func(jobID string) error {
+				waited = jobID
+				return tt.errJob
+			})
+
+			if diags.HasError() {
+				t.Fatalf("got errors %v", diags)
+			}
+			if status != tt.wantStatus {
+				t.Errorf("got status %s, want %s", status, tt.wantStatus)
+			}
+			if waited != tt.jobID {
+				t.Errorf("got job %q waited, want %q", waited, tt.jobID)
+			}
+			if got := diags.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("got warning %t, want %t", got, tt.wantWarning)
+			}
+			if jobID, _ := GetPendingJob(ctx, private); (jobID != "") != tt.wantPending {
+				t.Errorf("got pending job %q, want pending %t", jobID, tt.wantPending)
+			}
+		})
+	}
+}
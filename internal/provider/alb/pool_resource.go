@@ -120,7 +120,10 @@ func (r *albPoolResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.AddError("Unable to get Edge Gateway", err.Error())
 		return
 	}
-	edgeGW.Lock(ctx)
+	if errLock := edgeGW.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer edgeGW.Unlock(ctx)
 
 	// Create ALB Pool
@@ -255,7 +258,10 @@ func (r *albPoolResource) Update(ctx context.Context, req resource.UpdateRequest
 		resp.Diagnostics.AddError("Unable to get Edge Gateway", err.Error())
 		return
 	}
-	edgeGW.Lock(ctx)
+	if errLock := edgeGW.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer edgeGW.Unlock(ctx)
 
 	// Update ALB Pool.
@@ -291,7 +297,10 @@ func (r *albPoolResource) Delete(ctx context.Context, req resource.DeleteRequest
 		resp.Diagnostics.AddError("Unable to get Edge Gateway", err.Error())
 		return
 	}
-	edgeGW.Lock(ctx)
+	if errLock := edgeGW.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer edgeGW.Unlock(ctx)
 
 	// Get albPool
@@ -0,0 +1,476 @@
+// Package alb provides a Terraform resource.
+package alb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &albVirtualServiceResource{}
+	_ resource.ResourceWithConfigure   = &albVirtualServiceResource{}
+	_ resource.ResourceWithImportState = &albVirtualServiceResource{}
+)
+
+// NewAlbVirtualServiceResource is a helper function to simplify the provider implementation.
+func NewAlbVirtualServiceResource() resource.Resource {
+	return &albVirtualServiceResource{}
+}
+
+// albVirtualServiceResource is the resource implementation.
+type albVirtualServiceResource struct {
+	client *client.CloudAvenue
+	org    org.Org
+	edgegw edgegw.EdgeGateway
+}
+
+// Init Initializes the resource.
+func (r *albVirtualServiceResource) Init(ctx context.Context, rm *AlbVirtualServiceModel) (diags diag.Diagnostics) {
+	var err error
+
+	r.org, diags = org.Init(r.client)
+	if diags.HasError() {
+		return
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(rm.EdgeGatewayID.Get()),
+		Name: types.StringValue(rm.EdgeGatewayName.Get()),
+	})
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway", err.Error())
+		return
+	}
+
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *albVirtualServiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_virtual_service"
+}
+
+// Schema defines the schema for the resource.
+func (r *albVirtualServiceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = albVirtualServiceSchema(ctx).GetResource(ctx)
+}
+
+func (r *albVirtualServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *albVirtualServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &AlbVirtualServiceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource creation logic here.
+	*/
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	// The load balancer must be enabled on the Edge Gateway before creating a virtual service.
+	albSettings, err := r.edgegw.GetAlbSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving ALB settings of the Edge Gateway", err.Error())
+		return
+	}
+	if !albSettings.Enabled {
+		resp.Diagnostics.AddError(
+			"Load balancer is not enabled on the Edge Gateway",
+			fmt.Sprintf("The Edge Gateway %s has no load balancer enabled. Set lb_enabled to true on the cloudavenue_edgegateway resource.", r.edgegw.GetName()),
+		)
+		return
+	}
+
+	vsConfig, d := r.toNsxtAlbVirtualService(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdVS, err := r.client.Vmware.CreateNsxtAlbVirtualService(vsConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating ALB Virtual Service", err.Error())
+		return
+	}
+
+	plan.ID.Set(createdVS.NsxtAlbVirtualService.ID)
+
+	state, _, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *albVirtualServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &AlbVirtualServiceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource read here
+	*/
+
+	stateRefreshed, found, d := r.read(ctx, state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *albVirtualServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		plan  = &AlbVirtualServiceModel{}
+		state = &AlbVirtualServiceModel{}
+	)
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource update here
+	*/
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	vs, err := r.client.Vmware.GetAlbVirtualServiceById(state.ID.Get())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving ALB Virtual Service", err.Error())
+		return
+	}
+
+	vsConfig, d := r.toNsxtAlbVirtualService(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vsConfig.ID = vs.NsxtAlbVirtualService.ID
+
+	if _, err := vs.Update(vsConfig); err != nil {
+		resp.Diagnostics.AddError("Error updating ALB Virtual Service", err.Error())
+		return
+	}
+
+	stateRefreshed, _, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *albVirtualServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &AlbVirtualServiceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource deletion here
+	*/
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	vs, err := r.client.Vmware.GetAlbVirtualServiceById(state.ID.Get())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving ALB Virtual Service", err.Error())
+		return
+	}
+
+	if err := vs.Delete(); err != nil {
+		resp.Diagnostics.AddError("Error deleting ALB Virtual Service", err.Error())
+		return
+	}
+}
+
+func (r *albVirtualServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		edgegwID, edgegwName string
+		d                    diag.Diagnostics
+		err                  error
+		vs                   *govcd.NsxtAlbVirtualService
+	)
+
+	// Split req.ID with dot. ID format is EdgeGatewayIDOrName.VirtualServiceNameOrID
+	idParts := strings.Split(req.ID, ".")
+
+	if len(idParts) != 2 {
+		resp.Diagnostics.AddError("Invalid ID format", "ID format is EdgeGatewayIDOrName.VirtualServiceNameOrID")
+		return
+	}
+
+	r.org, d = org.Init(r.client)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	if uuid.IsEdgeGateway(idParts[0]) {
+		edgegwID = idParts[0]
+	} else {
+		edgegwName = idParts[0]
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(edgegwID),
+		Name: types.StringValue(edgegwName),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import ALB Virtual Service.", err.Error())
+		return
+	}
+
+	if uuid.IsLoadBalancerVirtualService(idParts[1]) {
+		vs, err = r.client.Vmware.GetAlbVirtualServiceById(idParts[1])
+	} else {
+		vs, err = r.client.Vmware.GetAlbVirtualServiceByName(r.edgegw.GetID(), idParts[1])
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get ALB Virtual Service.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vs.NsxtAlbVirtualService.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), vs.NsxtAlbVirtualService.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_id"), r.edgegw.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), r.edgegw.GetName())...)
+}
+
+// * CustomFuncs
+
+// toNsxtAlbVirtualService returns the NSX-T ALB Virtual Service representation of the plan
+// with the references to the Edge Gateway, the pool and the Service Engine Group resolved.
+func (r *albVirtualServiceResource) toNsxtAlbVirtualService(ctx context.Context, plan *AlbVirtualServiceModel) (vsConfig *govcdtypes.NsxtAlbVirtualService, diags diag.Diagnostics) {
+	vsConfig, diags = plan.ToNsxtAlbVirtualService(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	vsConfig.GatewayRef = govcdtypes.OpenApiReference{ID: r.edgegw.GetID()}
+
+	var (
+		pool *govcd.NsxtAlbPool
+		err  error
+	)
+
+	if plan.PoolID.IsKnown() {
+		pool, err = r.client.Vmware.GetAlbPoolById(plan.PoolID.Get())
+	} else {
+		pool, err = r.client.Vmware.GetAlbPoolByName(r.edgegw.GetID(), plan.PoolName.Get())
+	}
+	if err != nil {
+		diags.AddError("Error retrieving ALB Pool", err.Error())
+		return nil, diags
+	}
+	vsConfig.LoadBalancerPoolRef = govcdtypes.OpenApiReference{ID: pool.NsxtAlbPool.ID}
+
+	seg, err := r.getServiceEngineGroup(plan.ServiceEngineGroupName.Get())
+	if err != nil {
+		diags.AddError("Error retrieving Service Engine Group", err.Error())
+		return nil, diags
+	}
+	vsConfig.ServiceEngineGroupRef = govcdtypes.OpenApiReference{ID: seg.ID}
+
+	return vsConfig, diags
+}
+
+// getServiceEngineGroup returns the Service Engine Group assigned to the Edge Gateway with the given name.
+// If the name is empty, the first Service Engine Group assigned to the Edge Gateway is returned.
+func (r *albVirtualServiceResource) getServiceEngineGroup(name string) (*govcdtypes.OpenApiReference, error) {
+	queryParams := url.Values{}
+	queryParams.Add("filter", "gatewayRef.id=="+r.edgegw.GetID())
+
+	assignments, err := r.client.Vmware.GetAllAlbServiceEngineGroupAssignments(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, assignment := range assignments {
+		seg := assignment.NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef
+		if seg != nil && (name == "" || seg.Name == name) {
+			return seg, nil
+		}
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("no Service Engine Group is assigned to the Edge Gateway %s", r.edgegw.GetName())
+	}
+
+	return nil, fmt.Errorf("the Service Engine Group %s is not assigned to the Edge Gateway %s", name, r.edgegw.GetName())
+}
+
+func (r *albVirtualServiceResource) read(ctx context.Context, planOrState *AlbVirtualServiceModel) (stateRefreshed *AlbVirtualServiceModel, found bool, diags diag.Diagnostics) {
+	stateRefreshed = planOrState.Copy()
+
+	var (
+		vs  *govcd.NsxtAlbVirtualService
+		err error
+	)
+
+	if planOrState.ID.IsKnown() {
+		vs, err = r.client.Vmware.GetAlbVirtualServiceById(planOrState.ID.Get())
+	} else {
+		vs, err = r.client.Vmware.GetAlbVirtualServiceByName(r.edgegw.GetID(), planOrState.Name.Get())
+	}
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving ALB Virtual Service", err.Error())
+		return nil, true, diags
+	}
+
+	config := vs.NsxtAlbVirtualService
+
+	stateRefreshed.ID.Set(config.ID)
+	stateRefreshed.Name.Set(config.Name)
+	stateRefreshed.Description = utils.SuperStringValueOrNull(config.Description)
+	stateRefreshed.EdgeGatewayID.Set(r.edgegw.GetID())
+	stateRefreshed.EdgeGatewayName.Set(r.edgegw.GetName())
+	stateRefreshed.ApplicationProfileType.Set(config.ApplicationProfile.Type)
+	stateRefreshed.VirtualIP.Set(config.VirtualIpAddress)
+	stateRefreshed.PoolID.Set(config.LoadBalancerPoolRef.ID)
+	stateRefreshed.PoolName.Set(config.LoadBalancerPoolRef.Name)
+	stateRefreshed.ServiceEngineGroupName.Set(config.ServiceEngineGroupRef.Name)
+
+	if config.Enabled != nil {
+		stateRefreshed.Enabled.Set(*config.Enabled)
+	}
+
+	if config.CertificateRef != nil {
+		stateRefreshed.CertificateID = utils.SuperStringValueOrNull(config.CertificateRef.ID)
+	} else {
+		stateRefreshed.CertificateID.SetNull()
+	}
+
+	servicePorts := make(AlbVirtualServiceModelServicePorts, 0, len(config.ServicePorts))
+	for _, port := range config.ServicePorts {
+		x := AlbVirtualServiceModelServicePort{}
+		if port.PortStart != nil {
+			x.Start.Set(int64(*port.PortStart))
+		}
+		if port.PortEnd != nil {
+			x.End.Set(int64(*port.PortEnd))
+		} else {
+			x.End.Set(x.Start.Get())
+		}
+		x.SSLEnabled.Set(port.SslEnabled != nil && *port.SslEnabled)
+		if port.TcpUdpProfile != nil {
+			x.Type.Set(port.TcpUdpProfile.Type)
+		}
+		servicePorts = append(servicePorts, x)
+	}
+
+	diags.Append(stateRefreshed.ServicePorts.Set(ctx, servicePorts)...)
+	if diags.HasError() {
+		return nil, true, diags
+	}
+
+	return stateRefreshed, true, diags
+}
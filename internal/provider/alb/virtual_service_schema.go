@@ -0,0 +1,285 @@
+package alb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
+)
+
+/*
+albVirtualServiceSchema
+
+This function is used to create the schema for the ALB Virtual Service resource and datasource.
+*/
+func albVirtualServiceSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "Provides a resource to manage Advanced Load Balancer Virtual Services. A virtual service exposes an ALB Pool on a virtual IP address of the Edge Gateway.",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "Provides a data source to read Advanced Load Balancer Virtual Services. A virtual service exposes an ALB Pool on a virtual IP address of the Edge Gateway.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the ALB Virtual Service.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the ALB Virtual Service.",
+					Required:            true,
+				},
+			},
+			"edge_gateway_name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"edge_gateway_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"description": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The description of the ALB Virtual Service.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"enabled": superschema.SuperBoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Define if the ALB Virtual Service is enabled or not.",
+					Computed:            true,
+				},
+				Resource: &schemaR.BoolAttribute{
+					Optional: true,
+					Default:  booldefault.StaticBool(true),
+				},
+			},
+			"virtual_ip": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The virtual IP address on which the ALB Virtual Service is exposed.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						fstringvalidator.IsIP(),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"application_profile_type": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The type of traffic handled by the ALB Virtual Service.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						fstringvalidator.OneOfWithDescription(
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "HTTP",
+								Description: "HTTP traffic.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "HTTPS",
+								Description: "HTTPS traffic. The `certificate_id` attribute is required.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "L4",
+								Description: "Layer 4 TCP/UDP traffic.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "L4_TLS",
+								Description: "Layer 4 TCP traffic encrypted with TLS. The `certificate_id` attribute is required.",
+							},
+						),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"pool_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the ALB Pool exposed by the ALB Virtual Service.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("pool_id"), path.MatchRoot("pool_name")),
+					},
+				},
+			},
+			"pool_name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the ALB Pool exposed by the ALB Virtual Service.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("pool_id"), path.MatchRoot("pool_name")),
+					},
+				},
+			},
+			"service_engine_group_name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the Service Engine Group hosting the ALB Virtual Service.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "If not set, the first Service Engine Group assigned to the Edge Gateway is used.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"certificate_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the certificate used to secure the traffic. The certificate can be managed with the `cloudavenue_certificate` resource.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fstringvalidator.RequireIfAttributeIsOneOf(path.MatchRoot("application_profile_type"), []attr.Value{types.StringValue("HTTPS"), types.StringValue("L4_TLS")}),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"service_ports": superschema.SuperListNestedAttribute{
+				Common: &schemaR.ListNestedAttribute{
+					MarkdownDescription: "The service ports exposed by the ALB Virtual Service.",
+				},
+				Resource: &schemaR.ListNestedAttribute{
+					Required: true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+				DataSource: &schemaD.ListNestedAttribute{
+					Computed: true,
+				},
+				Attributes: superschema.Attributes{
+					"start": superschema.SuperInt64Attribute{
+						Common: &schemaR.Int64Attribute{
+							MarkdownDescription: "The first port of the range.",
+						},
+						Resource: &schemaR.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						DataSource: &schemaD.Int64Attribute{
+							Computed: true,
+						},
+					},
+					"end": superschema.SuperInt64Attribute{
+						Common: &schemaR.Int64Attribute{
+							MarkdownDescription: "The last port of the range.",
+							Computed:            true,
+						},
+						Resource: &schemaR.Int64Attribute{
+							MarkdownDescription: "If not set, only the `start` port is exposed.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+					},
+					"ssl_enabled": superschema.SuperBoolAttribute{
+						Common: &schemaR.BoolAttribute{
+							MarkdownDescription: "Enable SSL termination on the port. The `certificate_id` attribute is required.",
+							Computed:            true,
+						},
+						Resource: &schemaR.BoolAttribute{
+							Optional: true,
+							Default:  booldefault.StaticBool(false),
+						},
+					},
+					"type": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The TCP/UDP profile of the port.",
+							Computed:            true,
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+							Default:  stringdefault.StaticString("TCP_PROXY"),
+							Validators: []validator.String{
+								fstringvalidator.OneOfWithDescription(
+									fstringvalidator.OneOfWithDescriptionValues{
+										Value:       "TCP_PROXY",
+										Description: "The ALB terminates the client connection and opens a new one to the pool member. Required for the `HTTP`, `HTTPS` and `L4_TLS` application profiles.",
+									},
+									fstringvalidator.OneOfWithDescriptionValues{
+										Value:       "TCP_FAST_PATH",
+										Description: "The client connection is directly forwarded to the pool member.",
+									},
+									fstringvalidator.OneOfWithDescriptionValues{
+										Value:       "UDP_FAST_PATH",
+										Description: "The UDP traffic is directly forwarded to the pool member.",
+									},
+								),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
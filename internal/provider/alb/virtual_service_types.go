@@ -0,0 +1,158 @@
+package alb
+
+import (
+	"context"
+	"fmt"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+type AlbVirtualServiceModel struct {
+	ApplicationProfileType supertypes.StringValue     `tfsdk:"application_profile_type"`
+	CertificateID          supertypes.StringValue     `tfsdk:"certificate_id"`
+	Description            supertypes.StringValue     `tfsdk:"description"`
+	EdgeGatewayID          supertypes.StringValue     `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName        supertypes.StringValue     `tfsdk:"edge_gateway_name"`
+	Enabled                supertypes.BoolValue       `tfsdk:"enabled"`
+	ID                     supertypes.StringValue     `tfsdk:"id"`
+	Name                   supertypes.StringValue     `tfsdk:"name"`
+	PoolID                 supertypes.StringValue     `tfsdk:"pool_id"`
+	PoolName               supertypes.StringValue     `tfsdk:"pool_name"`
+	ServiceEngineGroupName supertypes.StringValue     `tfsdk:"service_engine_group_name"`
+	ServicePorts           supertypes.ListNestedValue `tfsdk:"service_ports"`
+	VirtualIP              supertypes.StringValue     `tfsdk:"virtual_ip"`
+}
+
+type AlbVirtualServiceModelServicePorts []AlbVirtualServiceModelServicePort
+
+// * ServicePort.
+type AlbVirtualServiceModelServicePort struct {
+	End        supertypes.Int64Value  `tfsdk:"end"`
+	SSLEnabled supertypes.BoolValue   `tfsdk:"ssl_enabled"`
+	Start      supertypes.Int64Value  `tfsdk:"start"`
+	Type       supertypes.StringValue `tfsdk:"type"`
+}
+
+func NewAlbVirtualService(t any) *AlbVirtualServiceModel {
+	switch x := t.(type) {
+	case tfsdk.State:
+		return &AlbVirtualServiceModel{
+			ApplicationProfileType: supertypes.NewStringNull(),
+			CertificateID:          supertypes.NewStringNull(),
+			Description:            supertypes.NewStringNull(),
+			EdgeGatewayID:          supertypes.NewStringUnknown(),
+			EdgeGatewayName:        supertypes.NewStringUnknown(),
+			Enabled:                supertypes.NewBoolUnknown(),
+			ID:                     supertypes.NewStringUnknown(),
+			Name:                   supertypes.NewStringNull(),
+			PoolID:                 supertypes.NewStringUnknown(),
+			PoolName:               supertypes.NewStringUnknown(),
+			ServiceEngineGroupName: supertypes.NewStringUnknown(),
+			ServicePorts:           supertypes.NewListNestedNull(x.Schema.GetAttributes()["service_ports"].GetType().(supertypes.ListNestedType).ElementType()),
+			VirtualIP:              supertypes.NewStringNull(),
+		}
+
+	case tfsdk.Plan:
+		return &AlbVirtualServiceModel{
+			ApplicationProfileType: supertypes.NewStringNull(),
+			CertificateID:          supertypes.NewStringNull(),
+			Description:            supertypes.NewStringNull(),
+			EdgeGatewayID:          supertypes.NewStringUnknown(),
+			EdgeGatewayName:        supertypes.NewStringUnknown(),
+			Enabled:                supertypes.NewBoolUnknown(),
+			ID:                     supertypes.NewStringUnknown(),
+			Name:                   supertypes.NewStringNull(),
+			PoolID:                 supertypes.NewStringUnknown(),
+			PoolName:               supertypes.NewStringUnknown(),
+			ServiceEngineGroupName: supertypes.NewStringUnknown(),
+			ServicePorts:           supertypes.NewListNestedNull(x.Schema.GetAttributes()["service_ports"].GetType().(supertypes.ListNestedType).ElementType()),
+			VirtualIP:              supertypes.NewStringNull(),
+		}
+
+	case tfsdk.Config:
+		return &AlbVirtualServiceModel{
+			ApplicationProfileType: supertypes.NewStringNull(),
+			CertificateID:          supertypes.NewStringNull(),
+			Description:            supertypes.NewStringNull(),
+			EdgeGatewayID:          supertypes.NewStringUnknown(),
+			EdgeGatewayName:        supertypes.NewStringUnknown(),
+			Enabled:                supertypes.NewBoolUnknown(),
+			ID:                     supertypes.NewStringUnknown(),
+			Name:                   supertypes.NewStringNull(),
+			PoolID:                 supertypes.NewStringUnknown(),
+			PoolName:               supertypes.NewStringUnknown(),
+			ServiceEngineGroupName: supertypes.NewStringUnknown(),
+			ServicePorts:           supertypes.NewListNestedNull(x.Schema.GetAttributes()["service_ports"].GetType().(supertypes.ListNestedType).ElementType()),
+			VirtualIP:              supertypes.NewStringNull(),
+		}
+
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+func (rm *AlbVirtualServiceModel) Copy() *AlbVirtualServiceModel {
+	x := &AlbVirtualServiceModel{}
+	utils.ModelCopy(rm, x)
+	return x
+}
+
+// GetServicePorts returns the value of the ServicePorts field.
+func (rm *AlbVirtualServiceModel) GetServicePorts(ctx context.Context) (values AlbVirtualServiceModelServicePorts, diags diag.Diagnostics) {
+	values = make(AlbVirtualServiceModelServicePorts, 0)
+	d := rm.ServicePorts.Get(ctx, &values, false)
+	return values, d
+}
+
+// * CustomFuncs
+
+// ToNsxtAlbVirtualService returns the NSX-T ALB Virtual Service representation of the model.
+// The references to the Edge Gateway, the pool and the Service Engine Group are set by the caller.
+func (rm *AlbVirtualServiceModel) ToNsxtAlbVirtualService(ctx context.Context) (*govcdtypes.NsxtAlbVirtualService, diag.Diagnostics) {
+	servicePorts, d := rm.GetServicePorts(ctx)
+	if d.HasError() {
+		return nil, d
+	}
+
+	vs := &govcdtypes.NsxtAlbVirtualService{
+		Name:        rm.Name.Get(),
+		Description: rm.Description.Get(),
+		Enabled:     utils.TakeBoolPointer(rm.Enabled.Get()),
+		ApplicationProfile: govcdtypes.NsxtAlbVirtualServiceApplicationProfile{
+			SystemDefined: true,
+			Type:          rm.ApplicationProfileType.Get(),
+		},
+		VirtualIpAddress: rm.VirtualIP.Get(),
+		ServicePorts:     make([]govcdtypes.NsxtAlbVirtualServicePort, 0, len(servicePorts)),
+	}
+
+	if rm.CertificateID.IsKnown() {
+		vs.CertificateRef = &govcdtypes.OpenApiReference{ID: rm.CertificateID.Get()}
+	}
+
+	for _, port := range servicePorts {
+		end := port.Start.Get()
+		if port.End.IsKnown() {
+			end = port.End.Get()
+		}
+
+		vs.ServicePorts = append(vs.ServicePorts, govcdtypes.NsxtAlbVirtualServicePort{
+			PortStart:  utils.TakeIntPointer(int(port.Start.Get())),
+			PortEnd:    utils.TakeIntPointer(int(end)),
+			SslEnabled: utils.TakeBoolPointer(port.SSLEnabled.Get()),
+			TcpUdpProfile: &govcdtypes.NsxtAlbVirtualServicePortTcpUdpProfile{
+				SystemDefined: true,
+				Type:          port.Type.Get(),
+			},
+		})
+	}
+
+	return vs, nil
+}
@@ -0,0 +1,336 @@
+// Package catalog provides a Terraform resource.
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/acl"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &aclResource{}
+	_ resource.ResourceWithConfigure   = &aclResource{}
+	_ resource.ResourceWithImportState = &aclResource{}
+	_ catalog                          = &aclResource{}
+)
+
+// NewACLResource is a helper function to simplify the provider implementation.
+func NewACLResource() resource.Resource {
+	return &aclResource{}
+}
+
+// aclResource is the resource implementation.
+type aclResource struct {
+	client   *client.CloudAvenue
+	adminOrg adminorg.AdminOrg
+	catalog  base
+}
+
+// Metadata returns the resource type name.
+func (r *aclResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_" + "acl"
+}
+
+// Schema defines the schema for the resource.
+func (r *aclResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = aclSchema().GetResource(ctx)
+}
+
+func (r *aclResource) Init(_ context.Context, rm *aclResourceModel) (diags diag.Diagnostics) {
+	r.catalog = base{
+		name: rm.CatalogName.ValueString(),
+		id:   rm.CatalogID.ValueString(),
+	}
+
+	r.adminOrg, diags = adminorg.Init(r.client)
+
+	return
+}
+
+func (r *aclResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *aclResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &aclResourceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create resource
+	state, d := r.createOrUpdateACL(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *aclResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &aclResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adminCatalog, err := r.GetCatalog()
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving catalog", err.Error())
+		return
+	}
+
+	// Request acl
+	controlAccessParams, err := adminCatalog.GetAccessControl(true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving control access", err.Error())
+		return
+	}
+
+	// SharedWithEveryone
+	everyoneAccessLevel := ""
+	if controlAccessParams.IsSharedToEveryone && controlAccessParams.EveryoneAccessLevel != nil {
+		everyoneAccessLevel = *controlAccessParams.EveryoneAccessLevel
+	}
+
+	stateRefreshed := &aclResourceModel{
+		ID:                  types.StringValue(adminCatalog.AdminCatalog.ID),
+		CatalogID:           types.StringValue(adminCatalog.AdminCatalog.ID),
+		CatalogName:         types.StringValue(adminCatalog.AdminCatalog.Name),
+		EveryoneAccessLevel: types.StringValue(everyoneAccessLevel),
+		SharedWith:          types.SetNull(types.ObjectType{AttrTypes: acl.SharedWithModelAttrTypes}),
+	}
+
+	if stateRefreshed.EveryoneAccessLevel.ValueString() == "" {
+		stateRefreshed.EveryoneAccessLevel = types.StringNull()
+	}
+
+	if controlAccessParams.AccessSettings != nil && len(controlAccessParams.AccessSettings.AccessSetting) > 0 {
+		accessControlListSet, err := acl.AccessControlListToSharedSet(controlAccessParams.AccessSettings.AccessSetting)
+		if err != nil {
+			resp.Diagnostics.AddError("Error converting slice AccessSetting into set", err.Error())
+			return
+		}
+
+		var d diag.Diagnostics
+		stateRefreshed.SharedWith, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: acl.SharedWithModelAttrTypes}, accessControlListSet)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Remove resource if all rights are null
+	if stateRefreshed.EveryoneAccessLevel.IsNull() && stateRefreshed.SharedWith.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *aclResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := &aclResourceModel{}
+
+	// Get current plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update resource
+	state, d := r.createOrUpdateACL(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &aclResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adminCatalog, err := r.GetCatalog()
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving catalog", err.Error())
+		return
+	}
+
+	// Delete catalog access control
+	if err := adminCatalog.RemoveAccessControl(true); err != nil {
+		resp.Diagnostics.AddError("Error deleting control access", err.Error())
+	}
+}
+
+func (r *aclResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if uuid.IsCatalog(req.ID) {
+		resource.ImportStatePassthroughID(ctx, path.Root(catalogID), req, resp)
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root(catalogName), req, resp)
+}
+
+func (r *aclResource) createOrUpdateACL(ctx context.Context, plan *aclResourceModel) (*aclResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	adminCatalog, err := r.GetCatalog()
+	if err != nil {
+		diags.AddError("Error retrieving catalog", err.Error())
+		return nil, diags
+	}
+
+	sharedList := []acl.SharedWithModel{}
+	diags.Append(plan.SharedWith.ElementsAs(ctx, &sharedList, true)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	controlAccessParams := &govcdtypes.ControlAccessParams{
+		IsSharedToEveryone: !plan.EveryoneAccessLevel.IsNull() && !plan.EveryoneAccessLevel.IsUnknown(),
+	}
+
+	sharedListOutput := []*acl.SharedWithModel{}
+
+	if controlAccessParams.IsSharedToEveryone {
+		controlAccessParams.EveryoneAccessLevel = plan.EveryoneAccessLevel.ValueStringPointer()
+	} else {
+		var accessSettings []*govcdtypes.AccessSetting
+
+		accessSettings, sharedListOutput, err = acl.SharedSetToAccessControl(r.client.Vmware, r.adminOrg.AdminOrg.AdminOrg, sharedList)
+		if err != nil {
+			diags.AddError("Error when reading shared_with from schema.", err.Error())
+			return nil, diags
+		}
+
+		controlAccessParams.AccessSettings = &govcdtypes.AccessSettingList{
+			AccessSetting: accessSettings,
+		}
+	}
+
+	if err := adminCatalog.SetAccessControl(controlAccessParams, true); err != nil {
+		diags.AddError("Error setting control access", err.Error())
+		return nil, diags
+	}
+
+	state := &aclResourceModel{
+		ID:                  types.StringValue(adminCatalog.AdminCatalog.ID),
+		CatalogID:           types.StringValue(adminCatalog.AdminCatalog.ID),
+		CatalogName:         types.StringValue(adminCatalog.AdminCatalog.Name),
+		EveryoneAccessLevel: plan.EveryoneAccessLevel,
+		SharedWith:          types.SetNull(types.ObjectType{AttrTypes: acl.SharedWithModelAttrTypes}),
+	}
+
+	if len(sharedListOutput) != 0 {
+		var d diag.Diagnostics
+		state.SharedWith, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: acl.SharedWithModelAttrTypes}, sharedListOutput)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return state, diags
+}
+
+// GetID returns the ID of the catalog.
+func (r *aclResource) GetID() string {
+	return r.catalog.id
+}
+
+// GetName returns the name of the catalog.
+func (r *aclResource) GetName() string {
+	return r.catalog.name
+}
+
+// GetIDOrName returns the ID if it is set, otherwise it returns the name.
+func (r *aclResource) GetIDOrName() string {
+	if r.GetID() != "" {
+		return r.GetID()
+	}
+	return r.GetName()
+}
+
+// GetCatalog returns the govcd.AdminCatalog.
+func (r *aclResource) GetCatalog() (*govcd.AdminCatalog, error) {
+	return r.adminOrg.GetAdminCatalogByNameOrId(r.GetIDOrName(), true)
+}
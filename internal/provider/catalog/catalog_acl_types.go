@@ -0,0 +1,11 @@
+package catalog
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type aclResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	CatalogID           types.String `tfsdk:"catalog_id"`
+	CatalogName         types.String `tfsdk:"catalog_name"`
+	EveryoneAccessLevel types.String `tfsdk:"everyone_access_level"`
+	SharedWith          types.Set    `tfsdk:"shared_with"`
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 )
 
 var (
@@ -113,6 +114,13 @@ func (d *catalogDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		updatedState.PreserveIdentityInformation = types.BoolValue(*catalog.AdminCatalog.PublishExternalCatalogParams.PreserveIdentityInfoFlag)
 	}
 
+	var diags diag.Diagnostics
+	updatedState.Metadata, diags = metadata.Get(ctx, catalog)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var (
 		rawMediaItemsList = make([]attr.Value, 0)
 		mediaItemList     = make([]string, 0)
@@ -16,6 +16,7 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -119,6 +120,19 @@ func (r *catalogResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.OwnerName = types.StringValue(c.AdminCatalog.Owner.User.Name)
 	plan.CreatedAt = types.StringValue(c.AdminCatalog.DateCreated)
 
+	// Set metadata
+	resp.Diagnostics.Append(metadata.Update(ctx, c, plan.Metadata, types.SetNull(types.ObjectType{AttrTypes: metadata.AttrTypes}))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var d diag.Diagnostics
+	plan.Metadata, d = metadata.Get(ctx, c)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -159,6 +173,13 @@ func (r *catalogResource) Read(ctx context.Context, req resource.ReadRequest, re
 	plan.CreatedAt = types.StringValue(adminCatalog.AdminCatalog.DateCreated)
 	plan.OwnerName = types.StringValue(adminCatalog.AdminCatalog.Owner.User.Name)
 
+	var d diag.Diagnostics
+	plan.Metadata, d = metadata.Get(ctx, adminCatalog)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -225,6 +246,19 @@ func (r *catalogResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
+	// Update metadata
+	resp.Diagnostics.Append(metadata.Update(ctx, adminCatalog, plan.Metadata, state.Metadata)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var d diag.Diagnostics
+	plan.Metadata, d = metadata.Get(ctx, adminCatalog)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
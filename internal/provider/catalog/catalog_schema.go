@@ -16,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 )
 
 func catalogDatasourceAttributes() map[string]schemaD.Attribute {
@@ -180,6 +182,7 @@ func catalogSchema() superschema.Schema {
 					},
 				},
 			},
+			metadata.SchemaName: metadata.SuperSchema(),
 			"delete_force": superschema.BoolAttribute{
 				Resource: &schemaR.BoolAttribute{
 					Required:            true,
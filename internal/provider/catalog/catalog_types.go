@@ -11,6 +11,7 @@ type catalogDataSourceModel struct {
 	Description types.String `tfsdk:"description"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	OwnerName   types.String `tfsdk:"owner_name"`
+	Metadata    types.Set    `tfsdk:"metadata"`
 
 	// SPECIFIC DATA SOURCE
 	PreserveIdentityInformation types.Bool  `tfsdk:"preserve_identity_information"`
@@ -29,6 +30,7 @@ type catalogResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	OwnerName   types.String `tfsdk:"owner_name"`
+	Metadata    types.Set    `tfsdk:"metadata"`
 
 	// SPECIFIC RESOURCE
 	StorageProfile  types.String `tfsdk:"storage_profile"`
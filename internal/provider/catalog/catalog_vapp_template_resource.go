@@ -0,0 +1,529 @@
+// Package catalog provides a Terraform resource.
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &vAppTemplateResource{}
+	_ resource.ResourceWithConfigure      = &vAppTemplateResource{}
+	_ resource.ResourceWithImportState    = &vAppTemplateResource{}
+	_ resource.ResourceWithModifyPlan     = &vAppTemplateResource{}
+	_ resource.ResourceWithValidateConfig = &vAppTemplateResource{}
+)
+
+// NewVAppTemplateResource is a helper function to simplify the provider implementation.
+func NewVAppTemplateResource() resource.Resource {
+	return &vAppTemplateResource{}
+}
+
+// vAppTemplateResource is the resource implementation.
+type vAppTemplateResource struct {
+	client *client.CloudAvenue
+	org    org.Org
+}
+
+// Init resource used to initialize the resource.
+func (r *vAppTemplateResource) Init(_ context.Context, _ *vAppTemplateResourceModel) (diags diag.Diagnostics) {
+	r.org, diags = org.Init(r.client)
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *vAppTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_" + "vapp_template"
+}
+
+// Schema defines the schema for the resource.
+func (r *vAppTemplateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = vappTemplateResourceSchema().GetResource(ctx)
+}
+
+func (r *vAppTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *vAppTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	config := &vAppTemplateResourceModel{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The chunk size is only used to upload a local file.
+	if config.FilePath.IsNull() && !config.UploadPieceSize.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("upload_piece_size"),
+			"Attribute ignored",
+			"The upload_piece_size attribute is only used with the file_path attribute.",
+		)
+	}
+
+	// The capture options are only used to capture a vApp.
+	if config.VAppID.IsNull() && config.VAppName.IsNull() {
+		for attribute, isNull := range map[string]bool{
+			"vdc":                      config.VDC.IsNull(),
+			"customize_on_instantiate": config.CustomizeOnInstantiate.IsNull(),
+			"overwrite":                config.Overwrite.IsNull(),
+		} {
+			if !isNull {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute),
+					"Invalid attribute combination",
+					fmt.Sprintf("The %s attribute can only be used with the vapp_id or vapp_name attributes.", attribute),
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan computes the checksum of the local file when it is not set in the configuration.
+func (r *vAppTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanChecksum(ctx, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *vAppTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &vAppTemplateResourceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create() is passed a default timeout to use if no value
+	// has been supplied in the Terraform configuration.
+	createTimeout, d := plan.Timeouts.Create(ctx, 60*time.Minute)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTO, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	catalog, err := r.getCatalog(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving catalog", err.Error())
+		return
+	}
+
+	if !plan.VAppID.IsNull() || !plan.VAppName.IsNull() {
+		resp.Diagnostics.Append(r.capture(ctxTO, catalog, plan)...)
+	} else {
+		resp.Diagnostics.Append(r.upload(ctxTO, catalog, plan)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, d := r.read(plan)
+	if !found {
+		resp.Diagnostics.AddError("Error creating vApp Template", fmt.Sprintf("The vApp Template %q was not found after its creation.", plan.TemplateName.ValueString()))
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *vAppTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &vAppTemplateResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRefreshed, found, d := r.read(state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *vAppTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		plan  = &vAppTemplateResourceModel{}
+		state = &vAppTemplateResourceModel{}
+	)
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Only the template_name and the description can be updated.
+		The other attributes are only stored in the state.
+	*/
+
+	if !plan.TemplateName.Equal(state.TemplateName) || !plan.Description.Equal(state.Description) {
+		catalog, err := r.getCatalog(state)
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving catalog", err.Error())
+			return
+		}
+
+		vAppTemplate, err := catalog.GetVAppTemplateById(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving vApp Template", err.Error())
+			return
+		}
+
+		vAppTemplate.VAppTemplate.Name = plan.TemplateName.ValueString()
+		vAppTemplate.VAppTemplate.Description = plan.Description.ValueString()
+		if _, err := vAppTemplate.Update(); err != nil {
+			resp.Diagnostics.AddError("Error updating vApp Template", err.Error())
+			return
+		}
+	}
+
+	stateRefreshed, found, d := r.read(plan)
+	if !found {
+		resp.Diagnostics.AddError("Error updating vApp Template", "The vApp Template was not found.")
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *vAppTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &vAppTemplateResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete() is passed a default timeout to use if no value
+	// has been supplied in the Terraform configuration.
+	deleteTimeout, d := state.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTO, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	vAppTemplate, err := r.client.Vmware.GetVAppTemplateById(state.ID.ValueString())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving vApp Template", err.Error())
+		return
+	}
+
+	task, err := vAppTemplate.DeleteAsync()
+	if err == nil {
+		err = waitTask(ctxTO, &task)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting vApp Template", err.Error())
+	}
+}
+
+func (r *vAppTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The name of a vApp Template can contain dots, the catalog name is everything before the first dot.
+	idParts := strings.SplitN(req.ID, ".", 2)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: catalog_name.template_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(catalogName), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("upload_piece_size"), 1)...)
+}
+
+// upload uploads the OVA or OVF file defined by file_path or url as a vApp Template in the catalog.
+func (r *vAppTemplateResource) upload(ctx context.Context, catalog *govcd.Catalog, rm *vAppTemplateResourceModel) (diags diag.Diagnostics) {
+	var err error
+
+	templateName := rm.TemplateName.ValueString()
+
+	// An existing catalog item with the same name makes the upload fail, it must not be removed below.
+	hrefBefore, err := catalogItemHREF(catalog, templateName)
+	if err != nil {
+		diags.AddError("Error retrieving vApp Template", err.Error())
+		return
+	}
+
+	if !rm.FilePath.IsNull() {
+		tflog.Info(ctx, "Uploading vApp Template from a local file", map[string]interface{}{
+			"file_path": rm.FilePath.ValueString(),
+			"catalog":   catalog.Catalog.Name,
+		})
+
+		uploadTask, errUpload := catalog.UploadOvf(rm.FilePath.ValueString(), templateName, rm.Description.ValueString(), rm.UploadPieceSize.ValueInt64()*1024*1024)
+		if errUpload == nil {
+			errUpload = waitUpload(ctx, uploadTask)
+		}
+		err = errUpload
+	} else {
+		tflog.Info(ctx, "Uploading vApp Template from a URL", map[string]interface{}{
+			"url":     rm.URL.ValueString(),
+			"catalog": catalog.Catalog.Name,
+		})
+
+		task, errUpload := catalog.UploadOvfByLink(rm.URL.ValueString(), templateName, rm.Description.ValueString())
+		if errUpload == nil {
+			errUpload = waitTask(ctx, &task)
+		}
+		err = errUpload
+	}
+	if err != nil {
+		diags.AddError("Error uploading vApp Template", err.Error())
+		// The catalog item is created before the upload, remove it to allow a new attempt.
+		if errDelete := removeCreatedCatalogItem(catalog, templateName, hrefBefore); errDelete != nil {
+			diags.AddWarning("Error removing the incomplete vApp Template", errDelete.Error())
+		}
+	}
+
+	return
+}
+
+// capture captures the vApp defined by vapp_id or vapp_name as a vApp Template in the catalog.
+func (r *vAppTemplateResource) capture(ctx context.Context, catalog *govcd.Catalog, rm *vAppTemplateResourceModel) (diags diag.Diagnostics) {
+	vdc, d := vdc.Init(r.client, rm.VDC)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	vApp, d := vapp.Init(r.client, vdc, rm.VAppID, rm.VAppName)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	// The vApp must not be modified during the capture.
+	diags.Append(vApp.LockVAPP(ctx)...)
+	if diags.HasError() {
+		return
+	}
+	defer vApp.UnlockVAPP(ctx)
+
+	templateName := rm.TemplateName.ValueString()
+	params := client.CaptureVAppParams{
+		Name:                   templateName,
+		Description:            rm.Description.ValueString(),
+		CustomizeOnInstantiate: rm.CustomizeOnInstantiate.ValueBool(),
+	}
+
+	item, err := catalog.GetCatalogItemByName(templateName, true)
+	if err != nil && !govcd.ContainsNotFound(err) {
+		diags.AddError("Error retrieving vApp Template", err.Error())
+		return
+	}
+
+	// An existing catalog item with the same name is overwritten or makes the capture fail, it must not be removed below.
+	hrefBefore := ""
+	if item != nil {
+		hrefBefore = item.CatalogItem.HREF
+		if rm.Overwrite.ValueBool() {
+			params.TargetCatalogItem = item
+		}
+	}
+
+	tflog.Info(ctx, "Capturing vApp as a vApp Template", map[string]interface{}{
+		"vapp":      vApp.GetName(),
+		"catalog":   catalog.Catalog.Name,
+		"overwrite": params.TargetCatalogItem != nil,
+	})
+
+	task, err := r.client.CaptureVApp(catalog, vApp.VAPP.VApp, params)
+	if err != nil {
+		diags.AddError("Error capturing vApp", err.Error())
+		return
+	}
+
+	if err := waitTask(ctx, task); err != nil {
+		diags.AddError("Error capturing vApp", err.Error())
+		// The catalog item is created before the capture, remove it to allow a new attempt.
+		if errDelete := removeCreatedCatalogItem(catalog, templateName, hrefBefore); errDelete != nil {
+			diags.AddWarning("Error removing the incomplete vApp Template", errDelete.Error())
+		}
+	}
+
+	return
+}
+
+// getCatalog returns the catalog of the vApp Template.
+func (r *vAppTemplateResource) getCatalog(rm *vAppTemplateResourceModel) (*govcd.Catalog, error) {
+	if rm.CatalogID.ValueString() != "" {
+		return r.org.GetCatalogByNameOrId(rm.CatalogID.ValueString(), true)
+	}
+	return r.org.GetCatalogByNameOrId(rm.CatalogName.ValueString(), true)
+}
+
+// read returns the state of the vApp Template and false if the vApp Template or its catalog does not exist.
+// The vApp Template is retrieved by its ID or by its name if the ID is not known (e.g. after an import).
+// The file_path, url, checksum, upload_piece_size, vdc, vapp_id, vapp_name and overwrite attributes are not returned by the API and are kept from the given model.
+func (r *vAppTemplateResource) read(rm *vAppTemplateResourceModel) (state *vAppTemplateResourceModel, found bool, diags diag.Diagnostics) {
+	catalog, err := r.getCatalog(rm)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving catalog", err.Error())
+		return nil, true, diags
+	}
+
+	var vAppTemplate *govcd.VAppTemplate
+	if rm.ID.ValueString() != "" {
+		vAppTemplate, err = catalog.GetVAppTemplateById(rm.ID.ValueString())
+	} else {
+		vAppTemplate, err = catalog.GetVAppTemplateByName(rm.TemplateName.ValueString())
+	}
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving vApp Template", err.Error())
+		return nil, true, diags
+	}
+
+	vmNames := make([]string, 0)
+	if vAppTemplate.VAppTemplate.Children != nil {
+		for _, vm := range vAppTemplate.VAppTemplate.Children.VM {
+			vmNames = append(vmNames, vm.Name)
+		}
+	}
+
+	vmNamesList, d := types.ListValueFrom(context.Background(), types.StringType, vmNames)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, true, diags
+	}
+
+	// The checksum is not computed for a remote file or a captured vApp.
+	checksum := rm.Checksum
+	if checksum.IsUnknown() {
+		checksum = types.StringNull()
+	}
+
+	customizeOnInstantiate := false
+	if vAppTemplate.VAppTemplate.CustomizationSection != nil {
+		customizeOnInstantiate = vAppTemplate.VAppTemplate.CustomizationSection.CustomizeOnInstantiate
+	}
+
+	// The overwrite option is only used at creation, it is null after an import.
+	overwrite := rm.Overwrite
+	if overwrite.IsNull() || overwrite.IsUnknown() {
+		overwrite = types.BoolValue(false)
+	}
+
+	state = &vAppTemplateResourceModel{
+		Timeouts:               rm.Timeouts,
+		ID:                     types.StringValue(vAppTemplate.VAppTemplate.ID),
+		CatalogID:              types.StringValue(catalog.Catalog.ID),
+		CatalogName:            types.StringValue(catalog.Catalog.Name),
+		TemplateName:           types.StringValue(vAppTemplate.VAppTemplate.Name),
+		Description:            types.StringValue(vAppTemplate.VAppTemplate.Description),
+		FilePath:               rm.FilePath,
+		URL:                    rm.URL,
+		Checksum:               checksum,
+		UploadPieceSize:        rm.UploadPieceSize,
+		VDC:                    rm.VDC,
+		VAppID:                 rm.VAppID,
+		VAppName:               rm.VAppName,
+		CustomizeOnInstantiate: types.BoolValue(customizeOnInstantiate),
+		Overwrite:              overwrite,
+		CreatedAt:              types.StringValue(vAppTemplate.VAppTemplate.DateCreated),
+		VMNames:                vmNamesList,
+	}
+
+	return state, true, nil
+}
@@ -8,8 +8,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
 )
 
 func vappTemplateSchema(ctx context.Context) schema.Schema {
@@ -53,3 +65,243 @@ func vappTemplateSchema(ctx context.Context) schema.Schema {
 		},
 	}
 }
+
+// vappTemplateSourcePaths are the attributes defining the source of the vApp Template. Exactly one of them must be set.
+var vappTemplateSourcePaths = []path.Expression{
+	path.MatchRoot("file_path"),
+	path.MatchRoot("url"),
+	path.MatchRoot("vapp_id"),
+	path.MatchRoot("vapp_name"),
+}
+
+/*
+vappTemplateResourceSchema
+
+This function is used to create the superschema for the vApp Template resource.
+*/
+func vappTemplateResourceSchema() superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The `catalog_vapp_template` resource allows you to create a vApp Template in a catalog. The vApp Template is either uploaded from an OVA or OVF file (from a local path or from a URL) or captured from an existing vApp.\n\n" +
+				"~> **Note:** Only the `template_name` and the `description` can be updated. Any change of the file (detected through the `checksum` attribute) or of the source vApp recreates the vApp Template.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"timeouts": &superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Delete: true,
+				},
+			},
+			"id": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the vApp Template.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			catalogID: superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the catalog in which the vApp Template is uploaded.",
+					Optional:            true,
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot(catalogName), path.MatchRoot(catalogID)),
+					},
+				},
+			},
+			catalogName: superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the catalog in which the vApp Template is uploaded.",
+					Optional:            true,
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot(catalogName), path.MatchRoot(catalogID)),
+					},
+				},
+			},
+			"template_name": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the vApp Template.",
+					Required:            true,
+				},
+			},
+			"description": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The description of the vApp Template.",
+					Optional:            true,
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Default: stringdefault.StaticString(""),
+				},
+			},
+			"file_path": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The local path of the OVA or OVF file to upload. For an OVF file, all the files referenced by the OVF descriptor must be located in the same folder.",
+					Optional:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						requiresReplaceIfNotImported(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(vappTemplateSourcePaths...),
+					},
+				},
+			},
+			"url": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The URL of the OVF file to upload. The URL must be reachable by Cloud Avenue.",
+					Optional:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						requiresReplaceIfNotImported(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(vappTemplateSourcePaths...),
+					},
+				},
+			},
+			"vdc": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the VDC of the vApp to capture. If not set, the VDC defined at provider level is used. Only used with `vapp_id` or `vapp_name`.",
+					Optional:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						requiresReplaceIfNotImported(),
+					},
+				},
+			},
+			"vapp_id": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the vApp to capture as a vApp Template.",
+					Optional:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						requiresReplaceIfNotImported(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(vappTemplateSourcePaths...),
+					},
+				},
+			},
+			"vapp_name": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the vApp to capture as a vApp Template.",
+					Optional:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						requiresReplaceIfNotImported(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(vappTemplateSourcePaths...),
+					},
+				},
+			},
+			"customize_on_instantiate": superschema.BoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "If true, the guest customization of the VMs is run when a vApp is instantiated from the vApp Template. Only used with `vapp_id` or `vapp_name`.",
+					Optional:            true,
+					Computed:            true,
+				},
+				Resource: &schemaR.BoolAttribute{
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.RequiresReplaceIfConfigured(),
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"overwrite": superschema.BoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "If true, an existing vApp Template with the same name in the catalog is overwritten by the captured vApp. Otherwise the capture fails if the name is already used. Only used with `vapp_id` or `vapp_name`.",
+					Optional:            true,
+					Computed:            true,
+				},
+				Resource: &schemaR.BoolAttribute{
+					Default: booldefault.StaticBool(false),
+				},
+			},
+			"checksum": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The checksum of the file to upload. Any change of the checksum recreates the vApp Template. If not set and `file_path` is used, the SHA256 checksum of the local file is computed during the plan. If not set and `url` is used, the checksum is not computed and a change of the remote file is not detected.",
+					Optional:            true,
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"upload_piece_size": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The size in MB of the chunks used to upload a local file. Only used with `file_path`.",
+					Optional:            true,
+					Computed:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					Default: int64default.StaticInt64(1),
+					Validators: []validator.Int64{
+						int64validator.Between(1, 1024),
+					},
+				},
+			},
+			"created_at": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The creation date of the vApp Template.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"vm_names": superschema.ListAttribute{
+				Common: &schemaR.ListAttribute{
+					MarkdownDescription: "The names of the VMs within the vApp Template.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				Resource: &schemaR.ListAttribute{
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// requiresReplaceIfNotImported requires a replacement when the value changes, except when the previous value is null.
+// The source of an imported vApp Template is unknown, setting it afterwards must not recreate the vApp Template.
+func requiresReplaceIfNotImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource. Setting the value after an import does not recreate the resource.",
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource. Setting the value after an import does not recreate the resource.",
+	)
+}
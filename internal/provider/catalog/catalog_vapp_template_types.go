@@ -1,6 +1,10 @@
 package catalog
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
 
 type vAppTemplateDataSourceModel struct {
 	ID           types.String `tfsdk:"id"`
@@ -12,3 +16,23 @@ type vAppTemplateDataSourceModel struct {
 	CreatedAt    types.String `tfsdk:"created_at"`
 	VMNames      types.List   `tfsdk:"vm_names"`
 }
+
+type vAppTemplateResourceModel struct {
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+	ID                     types.String   `tfsdk:"id"`
+	CatalogID              types.String   `tfsdk:"catalog_id"`
+	CatalogName            types.String   `tfsdk:"catalog_name"`
+	TemplateName           types.String   `tfsdk:"template_name"`
+	Description            types.String   `tfsdk:"description"`
+	FilePath               types.String   `tfsdk:"file_path"`
+	URL                    types.String   `tfsdk:"url"`
+	Checksum               types.String   `tfsdk:"checksum"`
+	UploadPieceSize        types.Int64    `tfsdk:"upload_piece_size"`
+	VDC                    types.String   `tfsdk:"vdc"`
+	VAppID                 types.String   `tfsdk:"vapp_id"`
+	VAppName               types.String   `tfsdk:"vapp_name"`
+	CustomizeOnInstantiate types.Bool     `tfsdk:"customize_on_instantiate"`
+	Overwrite              types.Bool     `tfsdk:"overwrite"`
+	CreatedAt              types.String   `tfsdk:"created_at"`
+	VMNames                types.List     `tfsdk:"vm_names"`
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
@@ -70,11 +71,24 @@ func (d *catalogsDataSource) Configure(ctx context.Context, req datasource.Confi
 
 func (d *catalogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	state := &catalogsDataSourceModel{}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(d.Init(ctx, state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	metadataFilter := make(map[string]string)
+	resp.Diagnostics.Append(state.MetadataFilter.ElementsAs(ctx, &metadataFilter, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	catalogs := make(map[string]catalogDataSourceModel)
 	catalogsName := make([]string, 0)
 
@@ -87,6 +101,15 @@ func (d *catalogsDataSource) Read(ctx context.Context, req datasource.ReadReques
 			resp.Diagnostics.AddError("Unable to get catalog", err.Error())
 			continue
 		} else {
+			match, err := metadata.Match(catalog, metadataFilter)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to get catalog metadata", err.Error())
+				continue
+			}
+			if !match {
+				continue
+			}
+
 			s := catalogDataSourceModel{
 				ID:          types.StringValue(catalog.AdminCatalog.ID),
 				Name:        types.StringValue(catalog.AdminCatalog.Name),
@@ -98,6 +121,10 @@ func (d *catalogsDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 			catalogsName = append(catalogsName, catalog.AdminCatalog.Name)
 
+			md, diags := metadata.Get(ctx, catalog)
+			resp.Diagnostics.Append(diags...)
+			s.Metadata = md
+
 			if catalog.AdminCatalog.Owner != nil && catalog.AdminCatalog.Owner.User != nil {
 				s.OwnerName = types.StringValue(catalog.AdminCatalog.Owner.User.Name)
 			} else {
@@ -154,9 +181,10 @@ func (d *catalogsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	updatedState := catalogsDataSourceModel{
-		ID:           utils.GenerateUUID("catalogs"),
-		Catalogs:     catalogs,
-		CatalogsName: cn,
+		ID:             utils.GenerateUUID("catalogs"),
+		Catalogs:       catalogs,
+		CatalogsName:   cn,
+		MetadataFilter: state.MetadataFilter,
 	}
 
 	// Save data into Terraform state
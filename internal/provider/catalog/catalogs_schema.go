@@ -4,6 +4,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 )
 
 func catalogsSchema() schema.Schema {
@@ -14,6 +16,7 @@ func catalogsSchema() schema.Schema {
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			metadata.SchemaFilterName: metadata.FilterSchema(),
 			"catalogs_name": schema.ListAttribute{
 				MarkdownDescription: "List of catalogs name.",
 				Computed:            true,
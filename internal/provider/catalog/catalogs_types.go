@@ -5,7 +5,8 @@ import (
 )
 
 type catalogsDataSourceModel struct {
-	ID           types.String                      `tfsdk:"id"`
-	Catalogs     map[string]catalogDataSourceModel `tfsdk:"catalogs"`
-	CatalogsName types.List                        `tfsdk:"catalogs_name"`
+	ID             types.String                      `tfsdk:"id"`
+	Catalogs       map[string]catalogDataSourceModel `tfsdk:"catalogs"`
+	CatalogsName   types.List                        `tfsdk:"catalogs_name"`
+	MetadataFilter types.Map                         `tfsdk:"metadata_filter"`
 }
@@ -0,0 +1,408 @@
+// Package catalog provides a Terraform resource.
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &catalogMediaResource{}
+	_ resource.ResourceWithConfigure   = &catalogMediaResource{}
+	_ resource.ResourceWithImportState = &catalogMediaResource{}
+	_ resource.ResourceWithModifyPlan  = &catalogMediaResource{}
+)
+
+// NewCatalogMediaResource is a helper function to simplify the provider implementation.
+func NewCatalogMediaResource() resource.Resource {
+	return &catalogMediaResource{}
+}
+
+// catalogMediaResource is the resource implementation.
+type catalogMediaResource struct {
+	client *client.CloudAvenue
+	org    org.Org
+}
+
+// Init resource used to initialize the resource.
+func (r *catalogMediaResource) Init(_ context.Context, _ *catalogMediaResourceModel) (diags diag.Diagnostics) {
+	r.org, diags = org.Init(r.client)
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *catalogMediaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_" + "media"
+}
+
+// Schema defines the schema for the resource.
+func (r *catalogMediaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = mediaSchema().GetResource(ctx)
+}
+
+func (r *catalogMediaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan computes the checksum of the local file when it is not set in the configuration.
+func (r *catalogMediaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanChecksum(ctx, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *catalogMediaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &catalogMediaResourceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create() is passed a default timeout to use if no value
+	// has been supplied in the Terraform configuration.
+	createTimeout, d := plan.Timeouts.Create(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTO, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	catalog, err := r.getCatalog(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving catalog", err.Error())
+		return
+	}
+
+	var (
+		mediaName = plan.Name.ValueString()
+		filePath  = plan.FilePath.ValueString()
+	)
+
+	// An existing media with the same name makes the upload fail, it must not be removed below.
+	hrefBefore, err := catalogItemHREF(catalog, mediaName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving media", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Uploading media", map[string]interface{}{
+		"file_path": filePath,
+		"catalog":   catalog.Catalog.Name,
+	})
+
+	// govcd only uploads ISO images, floppy images are uploaded by the client.
+	if strings.EqualFold(filepath.Ext(filePath), ".flp") {
+		task, errUpload := r.client.UploadFloppyMedia(catalog, mediaName, plan.Description.ValueString(), filePath)
+		if errUpload == nil && task != nil {
+			errUpload = waitTask(ctxTO, task)
+		}
+		err = errUpload
+	} else {
+		uploadTask, errUpload := catalog.UploadMediaImage(mediaName, plan.Description.ValueString(), filePath, plan.UploadPieceSize.ValueInt64()*1024*1024)
+		if errUpload == nil {
+			errUpload = waitUpload(ctxTO, uploadTask)
+		}
+		err = errUpload
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error uploading media", err.Error())
+		// The media is created before the upload, remove it to allow a new attempt.
+		if errDelete := removeCreatedCatalogItem(catalog, mediaName, hrefBefore); errDelete != nil {
+			resp.Diagnostics.AddWarning("Error removing the incomplete media", errDelete.Error())
+		}
+		return
+	}
+
+	state, found, d := r.read(plan)
+	if !found {
+		resp.Diagnostics.AddError("Error creating media", fmt.Sprintf("The media %q was not found after its upload.", mediaName))
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *catalogMediaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &catalogMediaResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRefreshed, found, d := r.read(state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *catalogMediaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := &catalogMediaResourceModel{}
+
+	// Get current plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		The media can not be updated, the other attributes require a replacement.
+		Only the force, upload_piece_size, file_path (after an import) and timeouts attributes are stored in the state.
+	*/
+
+	stateRefreshed, found, d := r.read(plan)
+	if !found {
+		resp.Diagnostics.AddError("Error updating media", "The media was not found.")
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *catalogMediaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &catalogMediaResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete() is passed a default timeout to use if no value
+	// has been supplied in the Terraform configuration.
+	deleteTimeout, d := state.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTO, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	catalog, err := r.getCatalog(state)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving catalog", err.Error())
+		return
+	}
+
+	media, err := catalog.GetMediaById(state.ID.ValueString())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving media", err.Error())
+		return
+	}
+
+	vms, err := r.client.GetVMsWithInsertedMedia(media.Media.HREF)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving the VMs in which the media is inserted", err.Error())
+		return
+	}
+
+	if len(vms) > 0 {
+		vmNames := make([]string, 0, len(vms))
+		for _, vm := range vms {
+			vmNames = append(vmNames, vm.VM.Name)
+		}
+
+		if !state.Force.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Media is inserted in VMs",
+				fmt.Sprintf("The media %q is inserted in the VMs %s. Eject the media or set the force attribute to true to eject it before the deletion.", media.Media.Name, strings.Join(vmNames, ", ")),
+			)
+			return
+		}
+
+		for _, vm := range vms {
+			tflog.Info(ctx, "Ejecting media", map[string]interface{}{
+				"media": media.Media.Name,
+				"vm":    vm.VM.Name,
+			})
+
+			if _, err := vm.HandleEjectMediaAndAnswer(r.org.Org.Org, catalog.Catalog.Name, media.Media.Name, true); err != nil {
+				resp.Diagnostics.AddError("Error ejecting media", fmt.Sprintf("Unable to eject the media from the VM %q: %s", vm.VM.Name, err.Error()))
+				return
+			}
+		}
+	}
+
+	task, err := media.Delete()
+	if err == nil {
+		err = waitTask(ctxTO, &task)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting media", err.Error())
+	}
+}
+
+func (r *catalogMediaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The name of a media can contain dots, the catalog name is everything before the first dot.
+	idParts := strings.SplitN(req.ID, ".", 2)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: catalog_name.media_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(catalogName), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("upload_piece_size"), 1)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force"), false)...)
+}
+
+// getCatalog returns the catalog of the media.
+func (r *catalogMediaResource) getCatalog(rm *catalogMediaResourceModel) (*govcd.Catalog, error) {
+	if rm.CatalogID.ValueString() != "" {
+		return r.org.GetCatalogByNameOrId(rm.CatalogID.ValueString(), true)
+	}
+	return r.org.GetCatalogByNameOrId(rm.CatalogName.ValueString(), true)
+}
+
+// read returns the state of the media and false if the media or its catalog does not exist.
+// The media is retrieved by its ID or by its name if the ID is not known (e.g. after an import).
+// The file_path, checksum, upload_piece_size and force attributes are not returned by the API and are kept from the given model.
+func (r *catalogMediaResource) read(rm *catalogMediaResourceModel) (state *catalogMediaResourceModel, found bool, diags diag.Diagnostics) {
+	catalog, err := r.getCatalog(rm)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving catalog", err.Error())
+		return nil, true, diags
+	}
+
+	var media *govcd.Media
+	if rm.ID.ValueString() != "" {
+		media, err = catalog.GetMediaById(rm.ID.ValueString())
+	} else {
+		media, err = catalog.GetMediaByName(rm.Name.ValueString(), true)
+	}
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving media", err.Error())
+		return nil, true, diags
+	}
+
+	mediaRecord, err := catalog.QueryMedia(media.Media.Name)
+	if err != nil {
+		diags.AddError("Error querying media", err.Error())
+		return nil, true, diags
+	}
+
+	if mediaRecord.MediaRecord == nil {
+		diags.AddError("Error querying media", "The media record is empty.")
+		return nil, true, diags
+	}
+
+	state = &catalogMediaResourceModel{
+		Timeouts:        rm.Timeouts,
+		ID:              types.StringValue(media.Media.ID),
+		Name:            types.StringValue(media.Media.Name),
+		CatalogID:       types.StringValue(catalog.Catalog.ID),
+		CatalogName:     types.StringValue(catalog.Catalog.Name),
+		Description:     types.StringValue(media.Media.Description),
+		FilePath:        rm.FilePath,
+		Checksum:        rm.Checksum,
+		UploadPieceSize: rm.UploadPieceSize,
+		Force:           rm.Force,
+		IsISO:           types.BoolValue(mediaRecord.MediaRecord.IsIso),
+		OwnerName:       types.StringValue(mediaRecord.MediaRecord.OwnerName),
+		IsPublished:     types.BoolValue(mediaRecord.MediaRecord.IsPublished),
+		CreatedAt:       types.StringValue(mediaRecord.MediaRecord.CreationDate),
+		Size:            types.Int64Value(mediaRecord.MediaRecord.StorageB),
+		Status:          types.StringValue(mediaRecord.MediaRecord.Status),
+		StorageProfile:  types.StringValue(mediaRecord.MediaRecord.StorageProfileName),
+	}
+
+	return state, true, nil
+}
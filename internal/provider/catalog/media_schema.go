@@ -7,9 +7,15 @@ import (
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
@@ -27,6 +33,12 @@ func mediaSchema() superschema.Schema {
 			MarkdownDescription: "retrieve information about a media in Cloud Avenue.",
 		},
 		Attributes: map[string]superschema.Attribute{
+			"timeouts": &superschema.TimeoutAttribute{
+				Resource: &superschema.ResourceTimeoutAttribute{
+					Create: true,
+					Delete: true,
+				},
+			},
 			"id": superschema.StringAttribute{
 				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The ID of the media.",
@@ -49,6 +61,17 @@ func mediaSchema() superschema.Schema {
 				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The ID of the catalog.",
 				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("catalog_name"), path.MatchRoot("catalog_id")),
+					},
+				},
 				DataSource: &schemaD.StringAttribute{
 					Optional: true,
 					Computed: true,
@@ -61,6 +84,17 @@ func mediaSchema() superschema.Schema {
 				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The name of the catalog.",
 				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("catalog_name"), path.MatchRoot("catalog_id")),
+					},
+				},
 				DataSource: &schemaD.StringAttribute{
 					Optional: true,
 					Computed: true,
@@ -73,6 +107,12 @@ func mediaSchema() superschema.Schema {
 				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The name of the media.",
 				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
 				DataSource: &schemaD.StringAttribute{
 					Optional: true,
 					Computed: true,
@@ -82,52 +122,123 @@ func mediaSchema() superschema.Schema {
 				},
 			},
 			"description": superschema.StringAttribute{
-				DataSource: &schemaD.StringAttribute{
+				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The description of the media.",
 					Computed:            true,
 				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Default:  stringdefault.StaticString(""),
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"file_path": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The local path of the ISO or floppy (`.flp` extension) file to upload.",
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						requiresReplaceIfNotImported(),
+					},
+				},
+			},
+			"checksum": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The checksum of the file to upload. Any change of the checksum recreates the media. If not set, the SHA256 checksum of the local file is computed during the plan.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"upload_piece_size": superschema.Int64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: "The size in MB of the chunks used to upload an ISO file.",
+					Optional:            true,
+					Computed:            true,
+					Default:             int64default.StaticInt64(1),
+					Validators: []validator.Int64{
+						int64validator.Between(1, 1024),
+					},
+				},
+			},
+			"force": superschema.BoolAttribute{
+				Resource: &schemaR.BoolAttribute{
+					MarkdownDescription: "Eject the media from the VMs in which it is inserted before deleting it. If `false`, the deletion of a media inserted in a VM fails.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
 			},
 			"is_iso": superschema.BoolAttribute{
-				DataSource: &schemaD.BoolAttribute{
+				Common: &schemaR.BoolAttribute{
 					MarkdownDescription: "`True` if the media is an ISO.",
 					Computed:            true,
 				},
+				Resource: &schemaR.BoolAttribute{
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
 			},
 			"owner_name": superschema.StringAttribute{
-				DataSource: &schemaD.StringAttribute{
+				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The name of the owner of the media.",
 					Computed:            true,
 				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
 			},
 			"is_published": superschema.BoolAttribute{
-				DataSource: &schemaD.BoolAttribute{
+				Common: &schemaR.BoolAttribute{
 					MarkdownDescription: "`True` if the media is published.",
 					Computed:            true,
 				},
 			},
 			"created_at": superschema.StringAttribute{
-				DataSource: &schemaD.StringAttribute{
+				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The date and time when the media was created.",
 					Computed:            true,
 				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
 			},
 			"size": superschema.Int64Attribute{
-				DataSource: &schemaD.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
 					MarkdownDescription: "The size of the media in bytes.",
 					Computed:            true,
 				},
+				Resource: &schemaR.Int64Attribute{
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+					},
+				},
 			},
 			"status": superschema.StringAttribute{
-				DataSource: &schemaD.StringAttribute{
+				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The status of the media.",
 					Computed:            true,
 				},
 			},
 			"storage_profile": superschema.StringAttribute{
-				DataSource: &schemaD.StringAttribute{
+				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "The storage profile of the media.",
 					Computed:            true,
 				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
 			},
 		},
 	}
@@ -2,6 +2,8 @@ package catalog
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 )
 
 type catalogMediaDataSourceModel struct {
@@ -18,3 +20,23 @@ type catalogMediaDataSourceModel struct {
 	Status         types.String `tfsdk:"status"`
 	StorageProfile types.String `tfsdk:"storage_profile"`
 }
+
+type catalogMediaResourceModel struct {
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	CatalogID       types.String   `tfsdk:"catalog_id"`
+	CatalogName     types.String   `tfsdk:"catalog_name"`
+	Description     types.String   `tfsdk:"description"`
+	FilePath        types.String   `tfsdk:"file_path"`
+	Checksum        types.String   `tfsdk:"checksum"`
+	UploadPieceSize types.Int64    `tfsdk:"upload_piece_size"`
+	Force           types.Bool     `tfsdk:"force"`
+	IsISO           types.Bool     `tfsdk:"is_iso"`
+	OwnerName       types.String   `tfsdk:"owner_name"`
+	IsPublished     types.Bool     `tfsdk:"is_published"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	Size            types.Int64    `tfsdk:"size"`
+	Status          types.String   `tfsdk:"status"`
+	StorageProfile  types.String   `tfsdk:"storage_profile"`
+}
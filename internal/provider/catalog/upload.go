@@ -0,0 +1,199 @@
+package catalog
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// uploadProgressInterval is the interval between two checks of the progress of an upload.
+const uploadProgressInterval = 5 * time.Second
+
+// fileChecksum returns the SHA256 checksum of a local file.
+func fileChecksum(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// modifyPlanChecksum computes the checksum of the local file set in the file_path attribute when the checksum attribute is not set in the configuration.
+// A replacement is required when the computed checksum differs from the checksum stored in the state.
+func modifyPlanChecksum(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configChecksum, filePath types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("checksum"), &configChecksum)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("file_path"), &filePath)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The checksum is set by the user or the file is not local.
+	if !configChecksum.IsNull() || filePath.IsNull() || filePath.IsUnknown() {
+		return
+	}
+
+	checksum, err := fileChecksum(filePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Error computing the checksum of the file", err.Error())
+		return
+	}
+	planChecksum := types.StringValue(checksum)
+
+	if !req.State.Raw.IsNull() {
+		var stateChecksum types.String
+
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("checksum"), &stateChecksum)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The checksum is null after an import, the file is not compared.
+		if !stateChecksum.IsNull() && !stateChecksum.Equal(planChecksum) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("checksum"))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("checksum"), planChecksum)...)
+}
+
+// isTaskRunning returns true if the task is not finished yet.
+func isTaskRunning(task *govcd.Task) bool {
+	switch task.Task.Status {
+	case "queued", "preRunning", "running":
+		return true
+	default:
+		return false
+	}
+}
+
+// waitUpload waits for the end of the upload of a local file and for the end of the import task.
+// The progress of the upload is logged through tflog. The task is cancelled if the context is done.
+func waitUpload(ctx context.Context, uploadTask govcd.UploadTask) error {
+	ticker := time.NewTicker(uploadProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := uploadTask.GetUploadError(); err != nil {
+			return err
+		}
+
+		progress := uploadTask.GetUploadProgress()
+		tflog.Info(ctx, "Upload in progress", map[string]interface{}{
+			"progress": progress + "%",
+		})
+		if progress == "100.00" {
+			break
+		}
+
+		// The upload may be cancelled from the Cloud Avenue portal.
+		if err := uploadTask.Refresh(); err != nil {
+			return err
+		}
+		if !isTaskRunning(uploadTask.Task) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return cancelTask(uploadTask.Task, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+
+	return waitTask(ctx, uploadTask.Task)
+}
+
+// waitTask waits for the end of a task. The progress of the task is logged through tflog.
+// The task is cancelled if the context is done.
+func waitTask(ctx context.Context, task *govcd.Task) error {
+	ticker := time.NewTicker(uploadProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		progress, err := task.GetTaskProgress()
+		if err != nil {
+			return err
+		}
+
+		if !isTaskRunning(task) {
+			// Returns the error of the task if it did not complete successfully.
+			return task.WaitTaskCompletion()
+		}
+
+		tflog.Info(ctx, "Import in progress", map[string]interface{}{
+			"progress": progress + "%",
+		})
+
+		select {
+		case <-ctx.Done():
+			return cancelTask(task, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// catalogItemHREF returns the HREF of the catalog item with the given name, or an empty string if it does not exist.
+func catalogItemHREF(catalog *govcd.Catalog, name string) (string, error) {
+	item, err := catalog.GetCatalogItemByName(name, true)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return item.CatalogItem.HREF, nil
+}
+
+// removeCreatedCatalogItem removes the catalog item with the given name left by a failed upload.
+// hrefBefore is the HREF of the catalog item with the same name before the upload, this item
+// was not created by the upload (e.g. the upload failed because the name is already used) and is kept.
+func removeCreatedCatalogItem(catalog *govcd.Catalog, name, hrefBefore string) error {
+	item, err := catalog.GetCatalogItemByName(name, true)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if item.CatalogItem.HREF == hrefBefore {
+		return nil
+	}
+
+	return item.Delete()
+}
+
+// cancelTask cancels a task and returns the given error.
+func cancelTask(task *govcd.Task, cause error) error {
+	if err := task.CancelTask(); err != nil {
+		return fmt.Errorf("%w (unable to cancel the task: %s)", cause, err.Error())
+	}
+	return cause
+}
@@ -0,0 +1,9 @@
+// Package certificate provides a Terraform resource and data source to manage the certificate library.
+package certificate
+
+const (
+	categoryName = "certificate"
+
+	// defaultExpirationWarningDays is the default number of days before the expiration of a certificate from which a warning is displayed.
+	defaultExpirationWarningDays = 30
+)
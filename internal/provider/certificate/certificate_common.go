@@ -0,0 +1,80 @@
+package certificate
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var errInvalidCertificate = errors.New("no PEM encoded certificate found")
+
+// certificateInfo contains the information extracted from a PEM encoded certificate.
+type certificateInfo struct {
+	NotAfter    time.Time
+	Fingerprint string
+}
+
+// parseCertificate returns the information of the first certificate of a PEM encoded certificate chain.
+func parseCertificate(certificatePEM string) (certificateInfo, error) {
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return certificateInfo{}, errInvalidCertificate
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return certificateInfo{}, err
+	}
+
+	sum := sha256.Sum256(cert.Raw)
+	fingerprint := make([]string, len(sum))
+	for i, b := range sum {
+		fingerprint[i] = fmt.Sprintf("%02X", b)
+	}
+
+	return certificateInfo{
+		NotAfter:    cert.NotAfter,
+		Fingerprint: strings.Join(fingerprint, ":"),
+	}, nil
+}
+
+// ExpiryDate returns the expiry date of the certificate in RFC3339 format.
+func (c certificateInfo) ExpiryDate() string {
+	return c.NotAfter.UTC().Format(time.RFC3339)
+}
+
+// expirationWarning adds a warning if the certificate expires within the given number of days.
+func (c certificateInfo) expirationWarning(attributePath path.Path, alias string, days int64) (diags diag.Diagnostics) {
+	remaining := time.Until(c.NotAfter)
+
+	switch {
+	case remaining <= 0:
+		diags.AddAttributeWarning(attributePath, "Certificate expired", fmt.Sprintf("The certificate %q expired on %s.", alias, c.ExpiryDate()))
+	case remaining <= time.Duration(days)*24*time.Hour:
+		diags.AddAttributeWarning(attributePath, "Certificate expires soon", fmt.Sprintf("The certificate %q expires on %s (in %d days).", alias, c.ExpiryDate(), int64(remaining.Hours()/24)))
+	}
+
+	return
+}
+
+// requiresReplaceIfNotImported returns a plan modifier that requires the replacement of the resource if the value changes.
+// The private key is not returned by the API, the value is null after an import and setting it does not replace the certificate.
+func requiresReplaceIfNotImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource. The value is not compared after an import.",
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource. The value is not compared after an import.",
+	)
+}
@@ -0,0 +1,121 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &certificateDataSource{}
+	_ datasource.DataSourceWithConfigure = &certificateDataSource{}
+)
+
+// NewCertificateDataSource returns a new certificate data source.
+func NewCertificateDataSource() datasource.DataSource {
+	return &certificateDataSource{}
+}
+
+// certificateDataSource implements the DataSource interface.
+type certificateDataSource struct {
+	client   *client.CloudAvenue
+	adminOrg adminorg.AdminOrg
+}
+
+// Metadata returns the data source type name.
+func (d *certificateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName
+}
+
+// Schema defines the schema for the data source.
+func (d *certificateDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = certificateSchema().GetDataSource(ctx)
+}
+
+// Configure configures the data source.
+func (d *certificateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read reads the data source.
+func (d *certificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	config := &certificateDataSourceModel{}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	d.adminOrg, diags = adminorg.Init(d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		cert *govcd.Certificate
+		err  error
+	)
+
+	if config.ID.ValueString() != "" {
+		cert, err = d.adminOrg.GetCertificateFromLibraryById(config.ID.ValueString())
+	} else {
+		cert, err = d.adminOrg.GetCertificateFromLibraryByName(config.Alias.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving certificate", err.Error())
+		return
+	}
+
+	info, err := parseCertificate(cert.CertificateLibrary.Certificate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing certificate", err.Error())
+		return
+	}
+
+	warningDays := int64(defaultExpirationWarningDays)
+	if !config.ExpirationWarningDays.IsNull() {
+		warningDays = config.ExpirationWarningDays.ValueInt64()
+	}
+	resp.Diagnostics.Append(info.expirationWarning(path.Root("expiry_date"), cert.CertificateLibrary.Alias, warningDays)...)
+
+	state := &certificateDataSourceModel{
+		ID:                    types.StringValue(cert.CertificateLibrary.Id),
+		Alias:                 types.StringValue(cert.CertificateLibrary.Alias),
+		Description:           types.StringValue(cert.CertificateLibrary.Description),
+		Certificate:           types.StringValue(cert.CertificateLibrary.Certificate),
+		ExpirationWarningDays: config.ExpirationWarningDays,
+		ExpiryDate:            types.StringValue(info.ExpiryDate()),
+		Fingerprint:           types.StringValue(info.Fingerprint),
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,316 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &certificateResource{}
+	_ resource.ResourceWithConfigure   = &certificateResource{}
+	_ resource.ResourceWithImportState = &certificateResource{}
+	_ resource.ResourceWithModifyPlan  = &certificateResource{}
+)
+
+// NewCertificateResource is a helper function to simplify the provider implementation.
+func NewCertificateResource() resource.Resource {
+	return &certificateResource{}
+}
+
+// certificateResource is the resource implementation.
+type certificateResource struct {
+	client   *client.CloudAvenue
+	adminOrg adminorg.AdminOrg
+}
+
+// Init Initializes the resource.
+func (r *certificateResource) Init(_ context.Context, _ *certificateResourceModel) (diags diag.Diagnostics) {
+	r.adminOrg, diags = adminorg.Init(r.client)
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *certificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName
+}
+
+// Schema defines the schema for the resource.
+func (r *certificateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = certificateSchema().GetResource(ctx)
+}
+
+func (r *certificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan computes the expiry date and the fingerprint of the certificate and warns if the certificate expires soon.
+func (r *certificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	plan := &certificateResourceModel{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Certificate.IsUnknown() || plan.Certificate.IsNull() {
+		return
+	}
+
+	info, err := parseCertificate(plan.Certificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate"), "Invalid certificate", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(info.expirationWarning(path.Root("certificate"), plan.Alias.ValueString(), plan.ExpirationWarningDays.ValueInt64())...)
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expiry_date"), info.ExpiryDate())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), info.Fingerprint)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *certificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &certificateResourceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.adminOrg.AddCertificateToLibrary(&govcdtypes.CertificateLibraryItem{
+		Alias:                plan.Alias.ValueString(),
+		Description:          plan.Description.ValueString(),
+		Certificate:          plan.Certificate.ValueString(),
+		PrivateKey:           plan.PrivateKey.ValueString(),
+		PrivateKeyPassphrase: plan.PrivateKeyPassphrase.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating certificate", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(cert.CertificateLibrary.Id)
+
+	state, found, d := r.read(ctx, plan)
+	if !found {
+		resp.Diagnostics.AddError("Error retrieving certificate", "The certificate is not found after its creation")
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *certificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &certificateResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRefreshed, found, d := r.read(ctx, state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Only the alias and the description can be updated, the other attributes require a replacement.
+func (r *certificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := &certificateResourceModel{}
+
+	// Get current plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.adminOrg.GetCertificateFromLibraryById(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving certificate", err.Error())
+		return
+	}
+
+	cert.CertificateLibrary.Alias = plan.Alias.ValueString()
+	cert.CertificateLibrary.Description = plan.Description.ValueString()
+
+	if _, err := cert.Update(); err != nil {
+		resp.Diagnostics.AddError("Error updating certificate", err.Error())
+		return
+	}
+
+	state, found, d := r.read(ctx, plan)
+	if !found {
+		resp.Diagnostics.AddError("Error retrieving certificate", "The certificate is not found after its update")
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *certificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &certificateResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.adminOrg.GetCertificateFromLibraryById(state.ID.ValueString())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving certificate", err.Error())
+		return
+	}
+
+	if err := cert.Delete(); err != nil {
+		resp.Diagnostics.AddError("Error deleting certificate", err.Error())
+	}
+}
+
+func (r *certificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format : certificateIDOrAlias
+	resp.Diagnostics.Append(r.Init(ctx, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		cert *govcd.Certificate
+		err  error
+	)
+
+	if uuid.IsCertificate(req.ID) {
+		cert, err = r.adminOrg.GetCertificateFromLibraryById(req.ID)
+	} else {
+		cert, err = r.adminOrg.GetCertificateFromLibraryByName(req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing certificate", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), cert.CertificateLibrary.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("expiration_warning_days"), defaultExpirationWarningDays)...)
+}
+
+// read returns the certificate. found is false if the certificate does not exist.
+// The private key and its passphrase are never returned by the API, they are kept from the given model.
+func (r *certificateResource) read(_ context.Context, rm *certificateResourceModel) (state *certificateResourceModel, found bool, diags diag.Diagnostics) {
+	cert, err := r.adminOrg.GetCertificateFromLibraryById(rm.ID.ValueString())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving certificate", err.Error())
+		return nil, true, diags
+	}
+
+	info, err := parseCertificate(cert.CertificateLibrary.Certificate)
+	if err != nil {
+		diags.AddError("Error parsing certificate", err.Error())
+		return nil, true, diags
+	}
+
+	state = &certificateResourceModel{
+		ID:                    types.StringValue(cert.CertificateLibrary.Id),
+		Alias:                 types.StringValue(cert.CertificateLibrary.Alias),
+		Description:           types.StringValue(cert.CertificateLibrary.Description),
+		Certificate:           types.StringValue(cert.CertificateLibrary.Certificate),
+		PrivateKey:            rm.PrivateKey,
+		PrivateKeyPassphrase:  rm.PrivateKeyPassphrase,
+		ExpirationWarningDays: rm.ExpirationWarningDays,
+		ExpiryDate:            types.StringValue(info.ExpiryDate()),
+		Fingerprint:           types.StringValue(info.Fingerprint),
+	}
+
+	// The API may reformat the PEM, the configured value is kept if it is the same certificate.
+	if !rm.Certificate.IsNull() && !rm.Certificate.IsUnknown() {
+		if current, err := parseCertificate(rm.Certificate.ValueString()); err == nil && current.Fingerprint == info.Fingerprint {
+			state.Certificate = rm.Certificate
+		}
+	}
+
+	return state, true, nil
+}
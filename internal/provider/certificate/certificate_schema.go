@@ -0,0 +1,150 @@
+package certificate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+)
+
+/*
+certificateSchema
+
+This function is used to create the schema for the certificate resource and datasource.
+*/
+func certificateSchema() superschema.Schema {
+	return superschema.Schema{
+		Common: superschema.SchemaDetails{
+			MarkdownDescription: "The certificate",
+		},
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "resource allows you to upload a certificate and its private key to the certificate library of the organization. The certificate can be used by the ALB virtual services and the IPsec VPN tunnels.",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "data source allows you to read a certificate of the certificate library of the organization.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the certificate.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("alias"), path.MatchRoot("id")),
+					},
+				},
+			},
+			"alias": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The alias (name) of the certificate in the library.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+				},
+				DataSource: &schemaD.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("alias"), path.MatchRoot("id")),
+					},
+				},
+			},
+			"description": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The description of the certificate.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Default:  stringdefault.StaticString(""),
+				},
+			},
+			"certificate": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The PEM encoded certificate. It can contain the certificate chain.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"private_key": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The PEM encoded private key of the certificate. The private key is never returned by the API, it is only sent when the certificate is created. The value is stored in the Terraform state (marked as sensitive).",
+					Optional:            true,
+					Sensitive:           true,
+					PlanModifiers: []planmodifier.String{
+						requiresReplaceIfNotImported(),
+					},
+				},
+			},
+			"private_key_passphrase": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The passphrase of the private key if it is encrypted. The passphrase is never returned by the API. The value is stored in the Terraform state (marked as sensitive).",
+					Optional:            true,
+					Sensitive:           true,
+					PlanModifiers: []planmodifier.String{
+						requiresReplaceIfNotImported(),
+					},
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRoot("private_key")),
+					},
+				},
+			},
+			"expiration_warning_days": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: "A warning is displayed during the plan when the certificate expires within this number of days.",
+					Computed:            true,
+					Default:             int64default.StaticInt64(defaultExpirationWarningDays),
+				},
+				DataSource: &schemaD.Int64Attribute{
+					MarkdownDescription: fmt.Sprintf("A warning is displayed during the read when the certificate expires within this number of days. If not set, the value is %d.", defaultExpirationWarningDays),
+				},
+			},
+			"expiry_date": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The expiry date of the certificate (RFC3339).",
+					Computed:            true,
+				},
+			},
+			"fingerprint": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The SHA-256 fingerprint of the certificate.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
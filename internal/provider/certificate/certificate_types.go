@@ -0,0 +1,25 @@
+package certificate
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type certificateResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Alias                 types.String `tfsdk:"alias"`
+	Description           types.String `tfsdk:"description"`
+	Certificate           types.String `tfsdk:"certificate"`
+	PrivateKey            types.String `tfsdk:"private_key"`
+	PrivateKeyPassphrase  types.String `tfsdk:"private_key_passphrase"`
+	ExpirationWarningDays types.Int64  `tfsdk:"expiration_warning_days"`
+	ExpiryDate            types.String `tfsdk:"expiry_date"`
+	Fingerprint           types.String `tfsdk:"fingerprint"`
+}
+
+type certificateDataSourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Alias                 types.String `tfsdk:"alias"`
+	Description           types.String `tfsdk:"description"`
+	Certificate           types.String `tfsdk:"certificate"`
+	ExpirationWarningDays types.Int64  `tfsdk:"expiration_warning_days"`
+	ExpiryDate            types.String `tfsdk:"expiry_date"`
+	Fingerprint           types.String `tfsdk:"fingerprint"`
+}
@@ -8,16 +8,33 @@ import (
 
 var cAMutexKV = mutex.NewKV()
 
-const keyLock = "cloudavenue:customer:api:lock"
+// LockKey is the scope of a lock on the cloudavenue customer API.
+type LockKey string
+
+// KeyOrg locks the calls to the cloudavenue customer API for the whole organization.
+// The creations (vDC, edge gateway, public IP) allocate resources shared by the organization
+// and are not documented as supported concurrently by the backend, they are serialized with this key.
+const KeyOrg LockKey = "cloudavenue:customer:api:lock"
+
+// KeyVDC locks the calls to the cloudavenue customer API changing an existing VDC or VDC group, or its edge gateways.
+func KeyVDC(name string) LockKey {
+	return LockKey("cloudavenue:customer:api:vdc:" + name)
+}
+
+// KeyEdgeGateway locks the calls to the cloudavenue customer API changing the public IPs of an existing edge gateway.
+func KeyEdgeGateway(name string) LockKey {
+	return LockKey("cloudavenue:customer:api:edgegateway:" + name)
+}
 
 // Lock
-// lock call to the cloudavenue customer API.
-func Lock(ctx context.Context) {
-	cAMutexKV.KvLock(ctx, keyLock)
+// lock call to the cloudavenue customer API for the given key.
+// It returns an error if ctx is done before the lock is acquired.
+func Lock(ctx context.Context, key LockKey) error {
+	return cAMutexKV.KvLock(ctx, string(key))
 }
 
 // Unlock
-// unlock call to the cloudavenue customer API.
-func Unlock(ctx context.Context) {
-	cAMutexKV.KvUnlock(ctx, keyLock)
+// unlock call to the cloudavenue customer API for the given key.
+func Unlock(ctx context.Context, key LockKey) {
+	cAMutexKV.KvUnlock(ctx, string(key))
 }
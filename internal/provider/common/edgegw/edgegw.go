@@ -68,8 +68,9 @@ func (e EdgeGateway) GetID() string {
 }
 
 // Lock locks the Edge Gateway.
-func (e EdgeGateway) Lock(ctx context.Context) {
-	gwMutexKV.KvLock(ctx, e.GetID())
+// It returns an error if ctx is done before the lock is acquired.
+func (e EdgeGateway) Lock(ctx context.Context) error {
+	return gwMutexKV.KvLock(ctx, e.GetID())
 }
 
 // Unlock unlocks the Edge Gateway.
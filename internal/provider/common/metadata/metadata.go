@@ -0,0 +1,362 @@
+// Package metadata provides the common schema and functions to manage the metadata of vCD objects.
+package metadata
+
+import (
+	"context"
+	"fmt"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
+)
+
+const (
+	// SchemaName is the name of the metadata attribute.
+	SchemaName = "metadata"
+	// SchemaFilterName is the name of the metadata filter attribute.
+	SchemaFilterName = "metadata_filter"
+
+	DomainGeneral = "GENERAL"
+	DomainSystem  = "SYSTEM"
+
+	TypeString   = "STRING"
+	TypeNumber   = "NUMBER"
+	TypeBoolean  = "BOOLEAN"
+	TypeDateTime = "DATETIME"
+)
+
+// typesMapping is the mapping between the type of the metadata value and the vCD typed value.
+var typesMapping = map[string]string{
+	TypeString:   govcdtypes.MetadataStringValue,
+	TypeNumber:   govcdtypes.MetadataNumberValue,
+	TypeBoolean:  govcdtypes.MetadataBooleanValue,
+	TypeDateTime: govcdtypes.MetadataDateTimeValue,
+}
+
+// Handler is the interface implemented by the vCD objects supporting metadata.
+// (VM, VApp, AdminVdc, AdminCatalog, OpenApiOrgVdcNetwork, ...).
+type Handler interface {
+	GetMetadata() (*govcdtypes.Metadata, error)
+	AddMetadataEntryWithVisibility(key, value, typedValue, visibility string, isSystem bool) error
+	DeleteMetadataEntryWithDomain(key string, isSystem bool) error
+}
+
+type Entry struct {
+	Key      types.String `tfsdk:"key"`
+	Value    types.String `tfsdk:"value"`
+	Type     types.String `tfsdk:"type"`
+	Domain   types.String `tfsdk:"domain"`
+	ReadOnly types.Bool   `tfsdk:"readonly"`
+}
+
+type Entries []Entry
+
+// AttrTypes is the attribute types of a metadata entry.
+var AttrTypes = map[string]attr.Type{
+	"key":      types.StringType,
+	"value":    types.StringType,
+	"type":     types.StringType,
+	"domain":   types.StringType,
+	"readonly": types.BoolType,
+}
+
+/*
+SuperSchema
+
+	For the resource :
+	Optional: true
+	Computed: true
+	UseStateForUnknown
+
+	For the data source :
+	Computed: true
+*/
+func SuperSchema() superschema.SetNestedAttribute {
+	return superschema.SetNestedAttribute{
+		Common: &schemaR.SetNestedAttribute{
+			MarkdownDescription: "The metadata (key/value tags) of the object.",
+			Computed:            true,
+		},
+		Resource: &schemaR.SetNestedAttribute{
+			MarkdownDescription: "If not set, the metadata of the object are not managed by Terraform.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		Attributes: superschema.Attributes{
+			"key": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The key of the metadata entry.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 256),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"value": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The value of the metadata entry.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"type": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The type of the metadata value.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Default:  stringdefault.StaticString(TypeString),
+					Validators: []validator.String{
+						fstringvalidator.OneOfWithDescription(
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       TypeString,
+								Description: "The value is a string.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       TypeNumber,
+								Description: "The value is a number.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       TypeBoolean,
+								Description: "The value is a boolean (`true` or `false`).",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       TypeDateTime,
+								Description: "The value is a date and time in RFC 3339 format (e.g. `2023-01-01T00:00:00Z`).",
+							},
+						),
+					},
+				},
+			},
+			"domain": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The domain of the metadata entry.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Default:  stringdefault.StaticString(DomainGeneral),
+					Validators: []validator.String{
+						fstringvalidator.OneOfWithDescription(
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       DomainGeneral,
+								Description: "The metadata entry is visible and editable by the users of the organization.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       DomainSystem,
+								Description: "The metadata entry is managed by the system administrator. The `readonly` attribute must be set to `true`.",
+							},
+						),
+					},
+				},
+			},
+			"readonly": superschema.BoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Define if the metadata entry is read-only for the users of the organization.",
+					Computed:            true,
+				},
+				Resource: &schemaR.BoolAttribute{
+					Optional: true,
+					Default:  booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+}
+
+/*
+FilterSchema
+
+Return the schema of the metadata filter used by the data sources to look up objects by metadata.
+*/
+func FilterSchema() schemaD.MapAttribute {
+	return schemaD.MapAttribute{
+		MarkdownDescription: "Only return the objects having all the metadata entries of this map (key = value). Only the metadata of the `GENERAL` domain are used.",
+		Optional:            true,
+		ElementType:         types.StringType,
+	}
+}
+
+// Get returns the metadata of the object as a Terraform set.
+func Get(ctx context.Context, h Handler) (types.Set, diag.Diagnostics) {
+	md, err := h.GetMetadata()
+	if err != nil {
+		d := diag.Diagnostics{}
+		d.AddError("Error retrieving metadata", err.Error())
+		return types.SetNull(types.ObjectType{AttrTypes: AttrTypes}), d
+	}
+
+	return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: AttrTypes}, fromVCD(md))
+}
+
+/*
+Update
+
+Update the metadata of the object to match the plan.
+The entries of the state not present in the plan are deleted.
+If the plan is unknown (attribute not set in the configuration), nothing is done.
+*/
+func Update(ctx context.Context, h Handler, plan, state types.Set) (diags diag.Diagnostics) {
+	if plan.IsNull() || plan.IsUnknown() {
+		return
+	}
+
+	planEntries := make(Entries, 0)
+	diags.Append(plan.ElementsAs(ctx, &planEntries, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	stateEntries := make(Entries, 0)
+	if !state.IsNull() && !state.IsUnknown() {
+		diags.Append(state.ElementsAs(ctx, &stateEntries, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	// Delete the entries removed from the plan
+	for _, entry := range stateEntries {
+		if planEntries.find(entry.Key.ValueString(), entry.Domain.ValueString()) == nil {
+			if err := h.DeleteMetadataEntryWithDomain(entry.Key.ValueString(), entry.isSystem()); err != nil {
+				diags.AddError("Error deleting metadata entry", fmt.Sprintf("Unable to delete metadata entry %q: %s", entry.Key.ValueString(), err))
+				return
+			}
+		}
+	}
+
+	// Add or update the entries of the plan
+	for _, entry := range planEntries {
+		if current := stateEntries.find(entry.Key.ValueString(), entry.Domain.ValueString()); current != nil && current.equal(entry) {
+			continue
+		}
+
+		if entry.isSystem() && !entry.ReadOnly.ValueBool() {
+			diags.AddError("Invalid metadata entry", fmt.Sprintf("The metadata entry %q is in the %s domain and must be readonly.", entry.Key.ValueString(), DomainSystem))
+			return
+		}
+
+		if err := h.AddMetadataEntryWithVisibility(entry.Key.ValueString(), entry.Value.ValueString(), typesMapping[entry.Type.ValueString()], entry.visibility(), entry.isSystem()); err != nil {
+			diags.AddError("Error setting metadata entry", fmt.Sprintf("Unable to set metadata entry %q: %s", entry.Key.ValueString(), err))
+			return
+		}
+	}
+
+	return
+}
+
+// Match returns true if the object has all the metadata entries of the filter in the GENERAL domain.
+func Match(h Handler, filter map[string]string) (bool, error) {
+	if len(filter) == 0 {
+		return true, nil
+	}
+
+	md, err := h.GetMetadata()
+	if err != nil {
+		return false, err
+	}
+
+	entries := fromVCD(md)
+	for key, value := range filter {
+		entry := entries.find(key, DomainGeneral)
+		if entry == nil || entry.Value.ValueString() != value {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// fromVCD converts the vCD metadata to metadata entries.
+func fromVCD(md *govcdtypes.Metadata) Entries {
+	entries := make(Entries, 0)
+	if md == nil {
+		return entries
+	}
+
+	for _, e := range md.MetadataEntry {
+		if e == nil || e.TypedValue == nil {
+			continue
+		}
+
+		entry := Entry{
+			Key:      types.StringValue(e.Key),
+			Value:    types.StringValue(e.TypedValue.Value),
+			Type:     types.StringValue(TypeString),
+			Domain:   types.StringValue(DomainGeneral),
+			ReadOnly: types.BoolValue(false),
+		}
+
+		for k, v := range typesMapping {
+			if v == e.TypedValue.XsiType {
+				entry.Type = types.StringValue(k)
+			}
+		}
+
+		if e.Domain != nil {
+			if e.Domain.Domain == DomainSystem {
+				entry.Domain = types.StringValue(DomainSystem)
+			}
+			entry.ReadOnly = types.BoolValue(e.Domain.Visibility == govcdtypes.MetadataReadOnlyVisibility)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// find returns the entry with the given key and domain or nil.
+func (e Entries) find(key, domain string) *Entry {
+	for i := range e {
+		if e[i].Key.ValueString() == key && e[i].Domain.ValueString() == domain {
+			return &e[i]
+		}
+	}
+	return nil
+}
+
+func (e Entry) isSystem() bool {
+	return e.Domain.ValueString() == DomainSystem
+}
+
+func (e Entry) visibility() string {
+	if e.ReadOnly.ValueBool() {
+		return govcdtypes.MetadataReadOnlyVisibility
+	}
+	return govcdtypes.MetadataReadWriteVisibility
+}
+
+func (e Entry) equal(other Entry) bool {
+	return e.Value.Equal(other.Value) &&
+		e.Type.Equal(other.Type) &&
+		e.ReadOnly.Equal(other.ReadOnly)
+}
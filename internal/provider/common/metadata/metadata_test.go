@@ -0,0 +1,146 @@
+package metadata
+
+import (
+	"context"
+	"testing"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakeHandler is an in-memory implementation of Handler.
+type fakeHandler struct {
+	entries map[string]*govcdtypes.MetadataEntry
+}
+
+func newFakeHandler() *fakeHandler {
+	return &fakeHandler{entries: make(map[string]*govcdtypes.MetadataEntry)}
+}
+
+func (f *fakeHandler) GetMetadata() (*govcdtypes.Metadata, error) {
+	md := &govcdtypes.Metadata{}
+	for _, e := range f.entries {
+		md.MetadataEntry = append(md.MetadataEntry, e)
+	}
+	return md, nil
+}
+
+func (f *fakeHandler) AddMetadataEntryWithVisibility(key, value, typedValue, visibility string, isSystem bool) error {
+	domain := DomainGeneral
+	if isSystem {
+		domain = DomainSystem
+	}
+	f.entries[domain+key] = &govcdtypes.MetadataEntry{
+		Key:        key,
+		TypedValue: &govcdtypes.MetadataTypedValue{XsiType: typedValue, Value: value},
+		Domain:     &govcdtypes.MetadataDomainTag{Domain: domain, Visibility: visibility},
+	}
+	return nil
+}
+
+func (f *fakeHandler) DeleteMetadataEntryWithDomain(key string, isSystem bool) error {
+	domain := DomainGeneral
+	if isSystem {
+		domain = DomainSystem
+	}
+	delete(f.entries, domain+key)
+	return nil
+}
+
+func toSet(t *testing.T, entries Entries) types.Set {
+	t.Helper()
+	s, d := types.SetValueFrom(context.Background(), types.ObjectType{AttrTypes: AttrTypes}, entries)
+	if d.HasError() {
+		t.Fatalf("unable to build set: %v", d)
+	}
+	return s
+}
+
+func newEntry(key, value, domain string, readonly bool) Entry {
+	return Entry{
+		Key:      types.StringValue(key),
+		Value:    types.StringValue(value),
+		Type:     types.StringValue(TypeString),
+		Domain:   types.StringValue(domain),
+		ReadOnly: types.BoolValue(readonly),
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+	h := newFakeHandler()
+	null := types.SetNull(types.ObjectType{AttrTypes: AttrTypes})
+
+	// Create
+	plan := toSet(t, Entries{newEntry("env", "dev", DomainGeneral, false), newEntry("owner", "team", DomainGeneral, true)})
+	if d := Update(ctx, h, plan, null); d.HasError() {
+		t.Fatalf("unexpected error: %v", d)
+	}
+
+	state, d := Get(ctx, h)
+	if d.HasError() {
+		t.Fatalf("unexpected error: %v", d)
+	}
+	if !state.Equal(plan) {
+		t.Errorf("expected state %v, got %v", plan, state)
+	}
+
+	// Update a value and remove an entry
+	plan = toSet(t, Entries{newEntry("env", "prod", DomainGeneral, false)})
+	if d := Update(ctx, h, plan, state); d.HasError() {
+		t.Fatalf("unexpected error: %v", d)
+	}
+
+	state, d = Get(ctx, h)
+	if d.HasError() {
+		t.Fatalf("unexpected error: %v", d)
+	}
+	if !state.Equal(plan) {
+		t.Errorf("expected state %v, got %v", plan, state)
+	}
+
+	// Unknown plan is ignored
+	if d := Update(ctx, h, types.SetUnknown(types.ObjectType{AttrTypes: AttrTypes}), state); d.HasError() {
+		t.Fatalf("unexpected error: %v", d)
+	}
+	if len(h.entries) != 1 {
+		t.Errorf("expected 1 entry, got %d", len(h.entries))
+	}
+
+	// SYSTEM entries must be readonly
+	plan = toSet(t, Entries{newEntry("sys", "value", DomainSystem, false)})
+	if d := Update(ctx, h, plan, state); !d.HasError() {
+		t.Errorf("expected an error for a writable SYSTEM entry")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	h := newFakeHandler()
+	_ = h.AddMetadataEntryWithVisibility("env", "dev", govcdtypes.MetadataStringValue, govcdtypes.MetadataReadWriteVisibility, false)
+	_ = h.AddMetadataEntryWithVisibility("secret", "x", govcdtypes.MetadataStringValue, govcdtypes.MetadataReadOnlyVisibility, true)
+
+	tests := []struct {
+		name   string
+		filter map[string]string
+		want   bool
+	}{
+		{name: "empty", filter: nil, want: true},
+		{name: "match", filter: map[string]string{"env": "dev"}, want: true},
+		{name: "wrongValue", filter: map[string]string{"env": "prod"}, want: false},
+		{name: "missingKey", filter: map[string]string{"env": "dev", "app": "web"}, want: false},
+		{name: "systemDomainIgnored", filter: map[string]string{"secret": "x"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Match(h, tt.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -17,40 +18,92 @@ var GlobalMutex = NewKV()
 //
 // The initial use case is to let aws_security_group_rule resources serialize
 // their access to individual security groups based on SG ID.
+//
+// The mutexes are channels with a buffer of one, so that waiting for a lock
+// can be cancelled through the context of the caller.
 type KV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*kvMutex
+}
+
+// kvMutex is the mutex of a key.
+type kvMutex struct {
+	ch chan struct{}
+	// lockedAt is the time the mutex has been locked, used to log how long it is held.
+	lockedAt time.Time
 }
 
 // NewKV is an implementation of KV.
 func NewKV() *KV {
 	return &KV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*kvMutex),
 	}
 }
 
-// KvLock locks the mutex for the given key. Caller is responsible for calling kvUnlock
-// for the same key.
-func (m *KV) KvLock(ctx context.Context, key string) {
-	tflog.Debug(ctx, fmt.Sprintf("Locking %q", key))
-	m.get(key).Lock()
-	tflog.Debug(ctx, fmt.Sprintf("Locked %q", key))
+// KvLock locks the mutex for the given key. Caller is responsible for calling KvUnlock
+// for the same key. It returns an error if ctx is done before the lock is acquired,
+// in which case KvUnlock must not be called.
+func (m *KV) KvLock(ctx context.Context, key string) error {
+	mutex := m.get(key)
+	start := time.Now()
+	waited := false
+
+	select {
+	case mutex.ch <- struct{}{}:
+	default:
+		// The key is locked by another caller, wait for it.
+		waited = true
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for lock %q", key))
+
+		select {
+		case mutex.ch <- struct{}{}:
+		case <-ctx.Done():
+			tflog.Warn(ctx, fmt.Sprintf("Gave up waiting for lock %q", key), map[string]interface{}{
+				"lock_wait": time.Since(start).String(),
+			})
+			return fmt.Errorf("waiting for lock %q for %s: %w", key, time.Since(start).Round(time.Millisecond), ctx.Err())
+		}
+	}
+
+	mutex.lockedAt = time.Now()
+	fields := map[string]interface{}{
+		"lock_wait": mutex.lockedAt.Sub(start).String(),
+	}
+	if waited {
+		// Contention is logged at info level to be visible without debug logs.
+		tflog.Info(ctx, fmt.Sprintf("Locked %q after waiting", key), fields)
+	} else {
+		tflog.Debug(ctx, fmt.Sprintf("Locked %q", key), fields)
+	}
+
+	return nil
 }
 
-// KvUnlock unlocks the mutex for the given key. Caller must have called kvLock for the same key first.
+// KvUnlock unlocks the mutex for the given key. Caller must have called KvLock for the same key first.
+// Unlocking a key which is not locked is logged as an error and has no effect.
 func (m *KV) KvUnlock(ctx context.Context, key string) {
-	tflog.Debug(ctx, fmt.Sprintf("Unlocking %q", key))
-	m.get(key).Unlock()
-	tflog.Debug(ctx, fmt.Sprintf("Unlocked %q", key))
+	mutex := m.get(key)
+	held := time.Since(mutex.lockedAt)
+
+	select {
+	case <-mutex.ch:
+	default:
+		tflog.Error(ctx, fmt.Sprintf("Unlocking %q which is not locked", key))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Unlocked %q", key), map[string]interface{}{
+		"lock_held": held.String(),
+	})
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status.
-func (m *KV) get(key string) *sync.Mutex {
+func (m *KV) get(key string) *kvMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &kvMutex{ch: make(chan struct{}, 1)}
 		m.store[key] = mutex
 	}
 	return mutex
@@ -0,0 +1,75 @@
+package mutex
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestKvLock(t *testing.T) {
+	ctx := context.Background()
+	m := NewKV()
+
+	if err := m.KvLock(ctx, "a"); err != nil {
+		t.Fatalf("lock a: %s", err)
+	}
+
+	// Another key is not blocked
+	if err := m.KvLock(ctx, "b"); err != nil {
+		t.Fatalf("lock b: %s", err)
+	}
+	m.KvUnlock(ctx, "b")
+
+	// The same key is blocked until it is unlocked
+	locked := make(chan error)
+	go func() {
+		locked <- m.KvLock(ctx, "a")
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("lock a: acquired while locked")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	m.KvUnlock(ctx, "a")
+	if err := <-locked; err != nil {
+		t.Fatalf("lock a after unlock: %s", err)
+	}
+	m.KvUnlock(ctx, "a")
+}
+
+func TestKvLockContextDone(t *testing.T) {
+	m := NewKV()
+
+	if err := m.KvLock(context.Background(), "a"); err != nil {
+		t.Fatalf("lock a: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := m.KvLock(ctx, "a")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("lock a with deadline: got %v, want %s", err, context.DeadlineExceeded)
+	}
+
+	// The lock is still held by the first caller
+	m.KvUnlock(context.Background(), "a")
+	if err := m.KvLock(context.Background(), "a"); err != nil {
+		t.Fatalf("lock a after unlock: %s", err)
+	}
+}
+
+func TestKvUnlockNotLocked(t *testing.T) {
+	m := NewKV()
+
+	// Unlocking a key which is not locked has no effect
+	m.KvUnlock(context.Background(), "a")
+
+	if err := m.KvLock(context.Background(), "a"); err != nil {
+		t.Fatalf("lock a: %s", err)
+	}
+	m.KvUnlock(context.Background(), "a")
+}
@@ -2,17 +2,36 @@ package network
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
+// ErrNetworkTypeNotSupported is returned when the type of the Org VDC network is neither routed nor isolated.
+var ErrNetworkTypeNotSupported = errors.New("only routed and isolated networks are supported")
+
 type Kind struct {
 	TypeOfNetwork Type
 }
 
+// GetKind returns the kind of an Org VDC network (routed or isolated).
+func GetKind(network *govcdtypes.OpenApiOrgVdcNetwork) (Kind, error) {
+	switch network.NetworkType {
+	case govcdtypes.OrgVdcNetworkTypeRouted:
+		return Kind{TypeOfNetwork: NAT_ROUTED}, nil
+	case govcdtypes.OrgVdcNetworkTypeIsolated:
+		return Kind{TypeOfNetwork: ISOLATED}, nil
+	default:
+		return Kind{}, fmt.Errorf("%w (type is %s)", ErrNetworkTypeNotSupported, network.NetworkType)
+	}
+}
+
 type GlobalResourceModel struct {
 	// BASE
 	ID                types.String
@@ -29,9 +48,12 @@ type GlobalResourceModel struct {
 	// ISOLATED
 
 	// ROUTED
-	EdgeGatewayID   types.String
-	EdgegatewayName types.String
-	InterfaceType   types.String
+	EdgeGatewayID    types.String
+	EdgegatewayName  types.String
+	InterfaceType    types.String
+	IPv6Gateway      types.String
+	IPv6PrefixLength types.Int64
+	IPv6StaticIPPool types.Set
 }
 
 type Network interface {
@@ -73,12 +95,12 @@ func (k Kind) SetNetworkAPIObject(ctx context.Context, data GlobalResourceModel)
 
 // setBaseNetworkAPIObject set the base network object with common attributes.
 func (g GlobalResourceModel) setBaseNetworkAPIObject(ctx context.Context) (*govcdtypes.OpenApiOrgVdcNetwork, diag.Diagnostics) {
-	ipRanges, d := g.setIPRanges(ctx)
+	ipRanges, d := setIPRanges(ctx, g.StaticIPPool)
 	if d.HasError() {
 		return nil, d
 	}
 
-	return &govcdtypes.OpenApiOrgVdcNetwork{
+	apiObject := &govcdtypes.OpenApiOrgVdcNetwork{
 		ID:          g.ID.ValueString(),
 		Name:        g.Name.ValueString(),
 		Description: g.Description.ValueString(),
@@ -97,17 +119,36 @@ func (g GlobalResourceModel) setBaseNetworkAPIObject(ctx context.Context) (*govc
 				},
 			},
 		},
-	}, d
+	}
+
+	// Dual-stack network, the IPv6 subnet is the secondary subnet
+	if g.IPv6Gateway.ValueString() != "" {
+		ipv6Ranges, d := setIPRanges(ctx, g.IPv6StaticIPPool)
+		if d.HasError() {
+			return nil, d
+		}
+
+		apiObject.EnableDualSubnetNetwork = utils.TakeBoolPointer(true)
+		apiObject.Subnets.Values = append(apiObject.Subnets.Values, govcdtypes.OrgVdcNetworkSubnetValues{
+			Gateway:      g.IPv6Gateway.ValueString(),
+			PrefixLength: int(g.IPv6PrefixLength.ValueInt64()),
+			IPRanges: govcdtypes.OrgVdcNetworkSubnetIPRanges{
+				Values: ipv6Ranges,
+			},
+		})
+	}
+
+	return apiObject, d
 }
 
 // Set Ip pool static for network.
-func (g GlobalResourceModel) setIPRanges(ctx context.Context) ([]govcdtypes.ExternalNetworkV2IPRange, diag.Diagnostics) {
+func setIPRanges(ctx context.Context, pools types.Set) ([]govcdtypes.ExternalNetworkV2IPRange, diag.Diagnostics) {
 	var (
 		d      = diag.Diagnostics{}
 		ipPool = []StaticIPPool{}
 	)
 
-	d.Append(g.StaticIPPool.ElementsAs(ctx, &ipPool, true)...)
+	d.Append(pools.ElementsAs(ctx, &ipPool, true)...)
 	if d.HasError() {
 		return nil, d
 	}
@@ -1,6 +1,8 @@
 package network
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
@@ -20,6 +22,7 @@ import (
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
 	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
 
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 )
@@ -226,6 +229,7 @@ func GetSchema(opts ...networkSchemaOpts) superschema.Schema {
 							Required: true,
 							Validators: []validator.String{
 								fstringvalidator.IsIP(),
+								SameIPFamilyAs(path.MatchRelative().AtParent().AtName("start_address")),
 							},
 						},
 						DataSource: &schemaD.StringAttribute{
@@ -286,12 +290,95 @@ func GetSchema(opts ...networkSchemaOpts) superschema.Schema {
 				},
 			},
 		}
+		_schema.Attributes["ipv6_gateway"] = superschema.StringAttribute{
+			Common: &schemaR.StringAttribute{
+				MarkdownDescription: "The gateway IPv6 address of the secondary subnet. Setting this value enables the dual-stack (IPv4 and IPv6) mode on the network.",
+			},
+			Resource: &schemaR.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					IsIPv6(),
+					stringvalidator.AlsoRequires(path.MatchRoot("ipv6_prefix_length")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(requiresReplaceIfStateNotNullString, ipv6ReplaceDescription, ipv6ReplaceDescription),
+				},
+			},
+			DataSource: &schemaD.StringAttribute{
+				Computed: true,
+			},
+		}
+		_schema.Attributes["ipv6_prefix_length"] = superschema.Int64Attribute{
+			Common: &schemaR.Int64Attribute{
+				MarkdownDescription: "The prefix length of the secondary IPv6 subnet. (e.g. 64)",
+			},
+			Resource: &schemaR.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 128),
+					int64validator.AlsoRequires(path.MatchRoot("ipv6_gateway")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(requiresReplaceIfStateNotNullInt64, ipv6ReplaceDescription, ipv6ReplaceDescription),
+				},
+			},
+			DataSource: &schemaD.Int64Attribute{
+				Computed: true,
+			},
+		}
+		_schema.Attributes["ipv6_static_ip_pool"] = superschema.SetNestedAttribute{
+			Common: &schemaR.SetNestedAttribute{
+				MarkdownDescription: "A set of static IPv6 pools to be used for the secondary subnet of this network.",
+			},
+			Resource: &schemaR.SetNestedAttribute{
+				Optional: true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.AlsoRequires(path.MatchRoot("ipv6_gateway")),
+				},
+			},
+			DataSource: &schemaD.SetNestedAttribute{
+				Computed: true,
+			},
+			Attributes: map[string]superschema.Attribute{
+				"start_address": superschema.StringAttribute{
+					Common: &schemaR.StringAttribute{
+						MarkdownDescription: "The start address of the IPv6 pool. This value must be a valid IPv6 address in the secondary subnet.",
+					},
+					Resource: &schemaR.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							IsIPv6(),
+						},
+					},
+					DataSource: &schemaD.StringAttribute{
+						Computed: true,
+					},
+				},
+				"end_address": superschema.StringAttribute{
+					Common: &schemaR.StringAttribute{
+						MarkdownDescription: "The end address of the IPv6 pool. This value must be a valid IPv6 address in the secondary subnet.",
+					},
+					Resource: &schemaR.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							IsIPv6(),
+						},
+					},
+					DataSource: &schemaD.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		}
+		_schema.Attributes[metadata.SchemaName] = metadata.SuperSchema()
 
 	case ISOLATED:
 		// Add isolated network specific attributes to the schema
 		_schema.Resource.MarkdownDescription = "Provides a Cloud Avenue VDC isolated Network. This can be used to create, modify, and delete VDC isolated networks."
 		_schema.DataSource.MarkdownDescription = "Provides a Cloud Avenue VDC isolated Network data source to read data or reference existing network."
 		_schema.Attributes["vdc"] = vdc.SuperSchema()
+		_schema.Attributes[metadata.SchemaName] = metadata.SuperSchema()
 
 	case ISOLATEDVAPP:
 		// Add isolated vApp network specific attributes to the schema
@@ -379,3 +466,15 @@ func GetSchema(opts ...networkSchemaOpts) superschema.Schema {
 	}
 	return _schema
 }
+
+const ipv6ReplaceDescription = "The secondary IPv6 subnet can be added to an existing network, changing or removing it requires the replacement of the network."
+
+// requiresReplaceIfStateNotNullString requires the replacement of the network when an existing IPv6 subnet is modified.
+func requiresReplaceIfStateNotNullString(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// requiresReplaceIfStateNotNullInt64 requires the replacement of the network when an existing IPv6 subnet is modified.
+func requiresReplaceIfStateNotNullInt64(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
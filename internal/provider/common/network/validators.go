@@ -0,0 +1,152 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IPFamily is the family of an IP address.
+type IPFamily string
+
+const (
+	IPv4 IPFamily = "IPv4"
+	IPv6 IPFamily = "IPv6"
+)
+
+// GetIPFamily returns the family of an IP address or of a network in CIDR notation.
+// The second value is false if the value is neither an IP address nor a CIDR.
+func GetIPFamily(value string) (IPFamily, bool) {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		var err error
+		ip, _, err = net.ParseCIDR(value)
+		if err != nil {
+			return "", false
+		}
+	}
+
+	if ip.To4() != nil {
+		return IPv4, true
+	}
+	return IPv6, true
+}
+
+var (
+	_ validator.String = ipFamilyValidator{}
+	_ validator.String = sameIPFamilyValidator{}
+)
+
+type ipFamilyValidator struct {
+	family IPFamily
+}
+
+// Description describes the validation in plain text formatting.
+func (v ipFamilyValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Must be a valid %s address", v.family)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v ipFamilyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v ipFamilyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if family, ok := GetIPFamily(req.ConfigValue.ValueString()); !ok || family != v.family {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			fmt.Sprintf("Invalid %s address", v.family),
+			fmt.Sprintf("invalid value: %s", req.ConfigValue.String()),
+		)
+	}
+}
+
+// IsIPv4 returns a validator which ensures that the configured attribute is a valid IPv4 address.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IsIPv4() validator.String {
+	return ipFamilyValidator{family: IPv4}
+}
+
+// IsIPv6 returns a validator which ensures that the configured attribute is a valid IPv6 address.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IsIPv6() validator.String {
+	return ipFamilyValidator{family: IPv6}
+}
+
+type sameIPFamilyValidator struct {
+	expressions path.Expressions
+}
+
+// Description describes the validation in plain text formatting.
+func (v sameIPFamilyValidator) Description(_ context.Context) string {
+	attributes := make([]string, 0, len(v.expressions))
+	for _, expression := range v.expressions {
+		if lastStep, _ := expression.Steps().LastStep(); lastStep != nil {
+			attributes = append(attributes, fmt.Sprintf("`%s`", lastStep))
+		}
+	}
+
+	return fmt.Sprintf("Must be of the same IP family (IPv4 or IPv6) as %s", strings.Join(attributes, ", "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sameIPFamilyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v sameIPFamilyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	family, ok := GetIPFamily(req.ConfigValue.ValueString())
+	if !ok {
+		// The format of the value is checked by the other validators.
+		return
+	}
+
+	for _, expression := range req.PathExpression.MergeExpressions(v.expressions...) {
+		matchedPaths, diags := req.Config.PathMatches(ctx, expression)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			var other types.String
+			diags := req.Config.GetAttribute(ctx, matchedPath, &other)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() || other.IsNull() || other.IsUnknown() {
+				continue
+			}
+
+			otherFamily, ok := GetIPFamily(strings.TrimSpace(other.ValueString()))
+			if ok && otherFamily != family {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Mixed IP families",
+					fmt.Sprintf("%s is an %s address but %s is an %s value, IPv4 and IPv6 cannot be mixed.", req.ConfigValue.String(), family, matchedPath, otherFamily),
+				)
+			}
+		}
+	}
+}
+
+// SameIPFamilyAs returns a validator which ensures that the configured attribute is of the
+// same IP family (IPv4 or IPv6) as the attributes matching the given expressions.
+// The other attributes may contain an IP address or a network in CIDR notation.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func SameIPFamilyAs(expressions ...path.Expression) validator.String {
+	return sameIPFamilyValidator{expressions: expressions}
+}
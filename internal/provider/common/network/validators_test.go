@@ -0,0 +1,64 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGetIPFamily(t *testing.T) {
+	tests := []struct {
+		value  string
+		family IPFamily
+		valid  bool
+	}{
+		{value: "192.168.1.1", family: IPv4, valid: true},
+		{value: "192.168.1.0/24", family: IPv4, valid: true},
+		{value: "2001:db8::1", family: IPv6, valid: true},
+		{value: "2001:db8::/64", family: IPv6, valid: true},
+		{value: "::ffff:192.168.1.1", family: IPv4, valid: true},
+		{value: "not-an-ip", valid: false},
+	}
+
+	for _, tt := range tests {
+		family, valid := GetIPFamily(tt.value)
+		if valid != tt.valid || family != tt.family {
+			t.Errorf("GetIPFamily(%q) = %q, %t; want %q, %t", tt.value, family, valid, tt.family, tt.valid)
+		}
+	}
+}
+
+func TestIPFamilyValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		expectErr bool
+	}{
+		{name: "ipv4-valid", validator: IsIPv4(), value: types.StringValue("10.0.0.1")},
+		{name: "ipv4-invalid", validator: IsIPv4(), value: types.StringValue("2001:db8::1"), expectErr: true},
+		{name: "ipv6-valid", validator: IsIPv6(), value: types.StringValue("2001:db8::1")},
+		{name: "ipv6-invalid", validator: IsIPv6(), value: types.StringValue("10.0.0.1"), expectErr: true},
+		{name: "ipv6-not-an-ip", validator: IsIPv6(), value: types.StringValue("foo"), expectErr: true},
+		{name: "null", validator: IsIPv6(), value: types.StringNull()},
+		{name: "unknown", validator: IsIPv4(), value: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.expectErr {
+				t.Errorf("expected error %t, got diagnostics: %v", tt.expectErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -169,7 +169,9 @@ func (v VAPP) LockVAPP(ctx context.Context) (d diag.Diagnostics) {
 		return
 	}
 	key := fmt.Sprintf("vdc:%s|vapp:%s", v.vdc.GetName(), v.GetName())
-	vcdMutexKV.KvLock(ctx, key)
+	if err := vcdMutexKV.KvLock(ctx, key); err != nil {
+		d.AddError("Error locking vApp", err.Error())
+	}
 	return
 }
 
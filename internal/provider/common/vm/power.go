@@ -0,0 +1,169 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+)
+
+const (
+	// PowerActionPowerOff powers off the VM immediately.
+	PowerActionPowerOff = "power_off"
+	// PowerActionShutdown shuts down the guest OS and falls back to PowerActionPowerOff after the shutdown timeout.
+	PowerActionShutdown = "shutdown"
+	// PowerActionSuspend suspends the VM.
+	PowerActionSuspend = "suspend"
+	// PowerActionReset powers off the VM without undeploying it to apply a change, the VM is powered on again right after the change.
+	PowerActionReset = "reset"
+
+	// DefaultShutdownTimeout is the default time (in seconds) given to the guest OS to shut down.
+	DefaultShutdownTimeout = 300
+
+	// powerPollInterval is the interval between two checks of a guest shutdown.
+	powerPollInterval = 5 * time.Second
+)
+
+// PowerActions is the list of the actions that can be used to stop a VM.
+var PowerActions = []string{
+	PowerActionPowerOff,
+	PowerActionShutdown,
+	PowerActionSuspend,
+	PowerActionReset,
+}
+
+// PowerPolicy defines how the provider stops a VM.
+type PowerPolicy struct {
+	Action          string
+	ShutdownTimeout time.Duration
+}
+
+// DefaultPowerPolicy returns the policy used when none is set. The VM is powered off immediately.
+func DefaultPowerPolicy() PowerPolicy {
+	return PowerPolicy{
+		Action:          PowerActionPowerOff,
+		ShutdownTimeout: DefaultShutdownTimeout * time.Second,
+	}
+}
+
+// Stop stops the VM according to the power policy.
+// Set reconfigure to true if the VM must be powered off to apply a change. A suspended VM cannot be reconfigured,
+// in this case the suspend action is replaced by a guest shutdown.
+func (v VM) Stop(ctx context.Context, c *client.CloudAvenue, policy PowerPolicy, reconfigure bool) error {
+	return stopVM(ctx, c, v.VM.VM, policy, reconfigure)
+}
+
+// stopVM stops a VM according to the power policy. Nothing is done if the VM is already stopped.
+func stopVM(ctx context.Context, c *client.CloudAvenue, vm *govcd.VM, policy PowerPolicy, reconfigure bool) error {
+	vmStatus, err := vm.GetStatus()
+	if err != nil {
+		return fmt.Errorf("error getting VM status before stopping it: %w", err)
+	}
+
+	switch stopAction(vmStatus, policy.Action, reconfigure) {
+	case "":
+		return nil
+
+	case PowerActionReset:
+		task, err := vm.PowerOff()
+		if err != nil {
+			return fmt.Errorf("error powering off VM: %w", err)
+		}
+		if err := task.WaitTaskCompletion(); err != nil {
+			return fmt.Errorf(errorCompletingTask, err)
+		}
+		return nil
+
+	case PowerActionSuspend:
+		task, err := c.SuspendVM(vm)
+		if err != nil {
+			return err
+		}
+		return task.WaitTaskCompletion()
+
+	case PowerActionShutdown:
+		err := shutdownGuest(ctx, vm, policy.ShutdownTimeout)
+		if err == nil {
+			return nil
+		}
+
+		tflog.Warn(ctx, "Unable to shut down the guest OS, the VM is powered off", map[string]interface{}{
+			"vm":    vm.VM.Name,
+			"error": err.Error(),
+		})
+	}
+
+	task, err := vm.Undeploy()
+	if err != nil {
+		return fmt.Errorf("error powering off VM: %w", err)
+	}
+	if err := task.WaitTaskCompletion(); err != nil {
+		return fmt.Errorf(errorCompletingTask, err)
+	}
+
+	return nil
+}
+
+// stopAction returns the action used to stop a VM with the given status, or an empty string if the VM is already stopped.
+func stopAction(vmStatus, action string, reconfigure bool) string {
+	if vmStatus == powerOFF || (vmStatus == powerSuspended && !reconfigure) {
+		return ""
+	}
+
+	switch {
+	case action == PowerActionSuspend && reconfigure:
+		action = PowerActionShutdown
+	case action == PowerActionReset && !reconfigure:
+		// The VM is not powered on again, it is stopped as with PowerActionPowerOff.
+		action = PowerActionPowerOff
+	}
+
+	// The guest OS can only be shut down or suspended if the VM is running.
+	if vmStatus != powerON {
+		action = PowerActionPowerOff
+	}
+
+	return action
+}
+
+// shutdownGuest shuts down the guest OS of a VM and waits until the VM is powered off.
+// The shutdown task is cancelled if the VM is still running after the timeout.
+func shutdownGuest(ctx context.Context, vm *govcd.VM, timeout time.Duration) error {
+	task, err := vm.Shutdown()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(powerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := task.Refresh(); err != nil {
+			return err
+		}
+
+		switch task.Task.Status {
+		case "queued", "preRunning", "running":
+		default:
+			// Returns the error of the task if it did not complete successfully.
+			return task.WaitTaskCompletion()
+		}
+
+		select {
+		case <-ctx.Done():
+			if err := task.CancelTask(); err != nil {
+				return fmt.Errorf("guest OS not shut down after %s (unable to cancel the task: %w)", timeout, err)
+			}
+			return fmt.Errorf("guest OS not shut down after %s", timeout)
+		case <-ticker.C:
+		}
+	}
+}
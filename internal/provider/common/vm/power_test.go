@@ -0,0 +1,55 @@
+package vm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStopAction(t *testing.T) {
+	tests := []struct {
+		name        string
+		vmStatus    string
+		action      string
+		reconfigure bool
+		want        string
+	}{
+		{name: "PowerOff", vmStatus: powerON, action: PowerActionPowerOff, reconfigure: true, want: PowerActionPowerOff},
+		{name: "Shutdown", vmStatus: powerON, action: PowerActionShutdown, reconfigure: true, want: PowerActionShutdown},
+		{name: "Suspend", vmStatus: powerON, action: PowerActionSuspend, want: PowerActionSuspend},
+		{name: "SuspendReconfigure", vmStatus: powerON, action: PowerActionSuspend, reconfigure: true, want: PowerActionShutdown},
+		{name: "Reset", vmStatus: powerON, action: PowerActionReset, reconfigure: true, want: PowerActionReset},
+		{name: "ResetPowerOnFalse", vmStatus: powerON, action: PowerActionReset, want: PowerActionPowerOff},
+		{name: "ResetSuspended", vmStatus: powerSuspended, action: PowerActionReset, reconfigure: true, want: PowerActionPowerOff},
+		{name: "ShutdownNotRunning", vmStatus: "PARTIALLY_POWERED_OFF", action: PowerActionShutdown, want: PowerActionPowerOff},
+		{name: "AlreadyPoweredOff", vmStatus: powerOFF, action: PowerActionReset, reconfigure: true, want: ""},
+		{name: "AlreadySuspended", vmStatus: powerSuspended, action: PowerActionSuspend, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stopAction(tt.vmStatus, tt.action, tt.reconfigure); got != tt.want {
+				t.Errorf("got action %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPowerActionsValidator(t *testing.T) {
+	v := stringvalidator.OneOf(PowerActions...)
+
+	for _, action := range []string{PowerActionPowerOff, PowerActionShutdown, PowerActionSuspend, PowerActionReset} {
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("power_action"),
+			ConfigValue: types.StringValue(action),
+		}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: got %v, want no error", action, resp.Diagnostics)
+		}
+	}
+}
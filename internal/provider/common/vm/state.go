@@ -3,34 +3,42 @@ package vm
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 const (
-	powerON = "POWERED_ON"
-	// powerOFF = "POWERED_OFF".
+	powerON        = "POWERED_ON"
+	powerOFF       = "POWERED_OFF"
+	powerSuspended = "SUSPENDED"
 )
 
 type VMResourceModelState struct { //nolint:revive
-	PowerON types.Bool   `tfsdk:"power_on"`
-	Status  types.String `tfsdk:"status"`
+	PowerON         types.Bool   `tfsdk:"power_on"`
+	Status          types.String `tfsdk:"status"`
+	PowerAction     types.String `tfsdk:"power_action"`
+	ShutdownTimeout types.Int64  `tfsdk:"shutdown_timeout"`
 }
 
 // attrTypes() returns the types of the attributes of the State attribute.
 func (s *VMResourceModelState) attrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"power_on": types.BoolType,
-		"status":   types.StringType,
+		"power_on":         types.BoolType,
+		"status":           types.StringType,
+		"power_action":     types.StringType,
+		"shutdown_timeout": types.Int64Type,
 	}
 }
 
 // toAttrValues() returns the values of the attributes of the State attribute.
 func (s *VMResourceModelState) toAttrValues() map[string]attr.Value {
 	return map[string]attr.Value{
-		"power_on": s.PowerON,
-		"status":   s.Status,
+		"power_on":         s.PowerON,
+		"status":           s.Status,
+		"power_action":     s.PowerAction,
+		"shutdown_timeout": s.ShutdownTimeout,
 	}
 }
 
@@ -47,9 +55,53 @@ func (s *VMResourceModelState) ToPlan(ctx context.Context) types.Object {
 		s.PowerON = types.BoolValue(false)
 	}
 
+	// The power policy is not returned by the API.
+	if s.PowerAction.IsNull() || s.PowerAction.IsUnknown() {
+		s.PowerAction = types.StringValue(PowerActionPowerOff)
+	}
+	if s.ShutdownTimeout.IsNull() || s.ShutdownTimeout.IsUnknown() {
+		s.ShutdownTimeout = types.Int64Value(DefaultShutdownTimeout)
+	}
+
 	return types.ObjectValueMust(s.attrTypes(), s.toAttrValues())
 }
 
+// ToDataSourcePlan returns the value of the State attribute of the data source as a types.Object.
+// The power policy is only defined in the resource.
+func (s *VMResourceModelState) ToDataSourcePlan(ctx context.Context) types.Object {
+	if s == nil {
+		return types.Object{}
+	}
+
+	state := s.ToPlan(ctx).Attributes()
+	delete(state, "power_action")
+	delete(state, "shutdown_timeout")
+
+	attrTypes := s.attrTypes()
+	delete(attrTypes, "power_action")
+	delete(attrTypes, "shutdown_timeout")
+
+	return types.ObjectValueMust(attrTypes, state)
+}
+
+// PowerPolicy returns the power policy defined in the State attribute.
+func (s *VMResourceModelState) PowerPolicy() PowerPolicy {
+	policy := DefaultPowerPolicy()
+
+	if s == nil {
+		return policy
+	}
+
+	if !s.PowerAction.IsNull() && !s.PowerAction.IsUnknown() {
+		policy.Action = s.PowerAction.ValueString()
+	}
+	if !s.ShutdownTimeout.IsNull() && !s.ShutdownTimeout.IsUnknown() {
+		policy.ShutdownTimeout = time.Duration(s.ShutdownTimeout.ValueInt64()) * time.Second
+	}
+
+	return policy
+}
+
 // StateRead returns the value of the State attribute, if set, as a *VMResourceModelState.
 func (v VM) StateRead(ctx context.Context) (*VMResourceModelState, error) {
 	status, err := v.GetStatus()
@@ -58,7 +110,9 @@ func (v VM) StateRead(ctx context.Context) (*VMResourceModelState, error) {
 	}
 
 	return &VMResourceModelState{
-		PowerON: types.BoolValue(v.IsPoweredON()),
-		Status:  types.StringValue(status),
+		PowerON:         types.BoolValue(v.IsPoweredON()),
+		Status:          types.StringValue(status),
+		PowerAction:     types.StringNull(),
+		ShutdownTimeout: types.Int64Null(),
 	}, nil
 }
@@ -21,6 +21,7 @@ type VMResourceModel struct { //nolint:revive
 	State       types.Object `tfsdk:"state"`
 	Resource    types.Object `tfsdk:"resource"`
 	Settings    types.Object `tfsdk:"settings"`
+	Metadata    types.Set    `tfsdk:"metadata"`
 }
 
 type VMResourceModelAllStructs struct { //nolint:revive
@@ -88,8 +89,10 @@ func (rm *VMResourceModel) StateFromPlan(ctx context.Context) (state *VMResource
 
 	if rm.State.IsNull() || rm.State.IsUnknown() {
 		return &VMResourceModelState{
-			PowerON: types.BoolNull(),
-			Status:  types.StringNull(),
+			PowerON:         types.BoolNull(),
+			Status:          types.StringNull(),
+			PowerAction:     types.StringNull(),
+			ShutdownTimeout: types.Int64Null(),
 		}, nil
 	}
 
@@ -75,7 +75,9 @@ func (v VM) LockVM(ctx context.Context) (d diag.Diagnostics) {
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, v.constructLockKey())
+	if err := mutex.GlobalMutex.KvLock(ctx, v.constructLockKey()); err != nil {
+		d.AddError("Error locking VM", err.Error())
+	}
 	return
 }
 
@@ -257,8 +259,9 @@ func PowerOnIfNeeded(vm *govcd.VM, busType string, allowVMReboot bool, vmStatusB
 	return nil
 }
 
-// PowerOffIfNeeded powers off a VM if it was powered off before and the bus type is IDE.
-func PowerOffIfNeeded(vm *govcd.VM, busType string, allowVMReboot bool) (string, error) {
+// PowerOffIfNeeded stops a VM according to the power policy if the bus type is IDE.
+// It returns the status of the VM before it was stopped.
+func PowerOffIfNeeded(ctx context.Context, c *client.CloudAvenue, vm *govcd.VM, busType string, allowVMReboot bool, policy PowerPolicy) (string, error) {
 	vmStatus, err := vm.GetStatus()
 	if err != nil {
 		return "", fmt.Errorf("error getting VM status before ensuring it is powered off: %w", err)
@@ -268,14 +271,9 @@ func PowerOffIfNeeded(vm *govcd.VM, busType string, allowVMReboot bool) (string,
 	if vmStatus != "POWERED_OFF" && busType == "ide" && allowVMReboot {
 		log.Printf("[DEBUG] Powering off VM %s for adding/updating internal disk.", vm.VM.Name)
 
-		task, err := vm.PowerOff()
-		if err != nil {
+		if err := stopVM(ctx, c, vm, policy, true); err != nil {
 			return vmStatusBefore, fmt.Errorf("error powering off VM for adding internal disk: %w", err)
 		}
-		err = task.WaitTaskCompletion()
-		if err != nil {
-			return vmStatusBefore, fmt.Errorf(errorCompletingTask, err)
-		}
 	}
 	return vmStatusBefore, nil
 }
@@ -0,0 +1,17 @@
+package disk
+
+const (
+	categoryName = "disk"
+
+	// Sharing types of an independent disk.
+	sharingTypeNone              = "None"
+	sharingTypeDiskSharing       = "DiskSharing"
+	sharingTypeControllerSharing = "ControllerSharing"
+)
+
+// sharingTypes is the list of the sharing types of an independent disk.
+var sharingTypes = []string{
+	sharingTypeNone,
+	sharingTypeDiskSharing,
+	sharingTypeControllerSharing,
+}
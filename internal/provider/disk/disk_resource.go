@@ -0,0 +1,354 @@
+// Package disk provides a Terraform resource.
+package disk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm/diskparams"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &diskResource{}
+	_ resource.ResourceWithConfigure   = &diskResource{}
+	_ resource.ResourceWithImportState = &diskResource{}
+)
+
+// NewDiskResource is a helper function to simplify the provider implementation.
+func NewDiskResource() resource.Resource {
+	return &diskResource{}
+}
+
+// diskResource is the resource implementation.
+type diskResource struct {
+	client *client.CloudAvenue
+	vdc    vdc.VDC
+}
+
+// Init Initializes the resource.
+func (r *diskResource) Init(_ context.Context, rm *diskResourceModel) (diags diag.Diagnostics) {
+	r.vdc, diags = vdc.Init(r.client, rm.VDC)
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *diskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName
+}
+
+// Schema defines the schema for the resource.
+func (r *diskResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = diskSchema().GetResource(ctx)
+}
+
+func (r *diskResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *diskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &diskResourceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if ok, err := r.vdc.DiskExist(plan.Name.ValueString()); ok {
+		resp.Diagnostics.AddError("Disk already exists", "Disk with name "+plan.Name.ValueString()+" already exists")
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Error checking disk", "Error checking if disk with name "+plan.Name.ValueString()+" already exists. Error : "+err.Error())
+		return
+	}
+
+	busType := diskparams.GetBusTypeByName(plan.BusType.ValueString())
+
+	diskCreateParams := &govcdtypes.DiskCreateParams{
+		Disk: &govcdtypes.Disk{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+			SizeMb:      plan.SizeInMb.ValueInt64(),
+			BusType:     busType.Code(),
+			BusSubType:  busType.SubType(),
+			SharingType: plan.SharingType.ValueString(),
+		},
+	}
+
+	if !plan.IOPS.IsNull() && !plan.IOPS.IsUnknown() {
+		iops := int(plan.IOPS.ValueInt64())
+		diskCreateParams.Disk.Iops = &iops
+	}
+
+	// If the storage profile is not set, the default storage profile of the vDC is used.
+	if !plan.StorageProfile.IsNull() && !plan.StorageProfile.IsUnknown() {
+		storageReference, err := r.vdc.FindStorageProfileReference(plan.StorageProfile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Storage profile not found", fmt.Sprintf("The storage profile %s does not exist in the vDC", plan.StorageProfile.ValueString()))
+			return
+		}
+		diskCreateParams.Disk.StorageProfile = &govcdtypes.Reference{HREF: storageReference.HREF}
+	}
+
+	task, err := r.vdc.CreateDisk(diskCreateParams)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating disk", err.Error())
+		return
+	}
+
+	if err = task.WaitTaskCompletion(); err != nil {
+		resp.Diagnostics.AddError("Error creating disk", err.Error())
+		return
+	}
+
+	disk, err := r.vdc.GetDiskByHref(task.Task.Owner.HREF)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving disk", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(disk.Disk.Id)
+
+	state, found, d := r.read(ctx, plan)
+	if !found {
+		resp.Diagnostics.AddError("Error retrieving disk", "The disk was not found after its creation")
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *diskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &diskResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRefreshed, found, d := r.read(ctx, state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *diskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := &diskResourceModel{}
+	state := &diskResourceModel{}
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disk, err := r.vdc.GetDiskById(state.ID.ValueString(), true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving disk", err.Error())
+		return
+	}
+
+	diskUpdate := &govcdtypes.Disk{
+		Name:           plan.Name.ValueString(),
+		Description:    plan.Description.ValueString(),
+		SizeMb:         plan.SizeInMb.ValueInt64(),
+		StorageProfile: disk.Disk.StorageProfile,
+		Owner:          disk.Disk.Owner,
+	}
+
+	if !plan.StorageProfile.Equal(state.StorageProfile) && !plan.StorageProfile.IsUnknown() {
+		storageReference, err := r.vdc.FindStorageProfileReference(plan.StorageProfile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Storage profile not found", fmt.Sprintf("The storage profile %s does not exist in the vDC", plan.StorageProfile.ValueString()))
+			return
+		}
+		diskUpdate.StorageProfile = &govcdtypes.Reference{HREF: storageReference.HREF, Name: storageReference.Name}
+	}
+
+	// The disk can only be updated if it is not attached to a VM.
+	task, err := disk.Update(diskUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating disk", fmt.Sprintf("unable to update disk %s (id:%s): %s. The disk must be detached from the VM to be updated.", state.Name.ValueString(), state.ID.ValueString(), err))
+		return
+	}
+
+	if err = task.WaitTaskCompletion(); err != nil {
+		resp.Diagnostics.AddError("Error updating disk", err.Error())
+		return
+	}
+
+	stateRefreshed, found, d := r.read(ctx, plan)
+	if !found {
+		resp.Diagnostics.AddError("Error retrieving disk", "The disk was not found after its update")
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *diskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &diskResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disk, err := r.vdc.GetDiskById(state.ID.ValueString(), true)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving disk", err.Error())
+		return
+	}
+
+	attachedVMsHREFs, err := disk.GetAttachedVmsHrefs()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving attached VMs", err.Error())
+		return
+	}
+
+	if len(attachedVMsHREFs) > 0 {
+		resp.Diagnostics.AddError("Disk is attached", fmt.Sprintf("The disk %s is attached to %d VM(s). Detach the disk before deleting it.", state.Name.ValueString(), len(attachedVMsHREFs)))
+		return
+	}
+
+	task, err := disk.Delete()
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting disk", err.Error())
+		return
+	}
+
+	if err = task.WaitTaskCompletion(); err != nil {
+		resp.Diagnostics.AddError("Error deleting disk", err.Error())
+	}
+}
+
+func (r *diskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format : vdc.diskID or diskID
+	idParts := strings.Split(req.ID, ".")
+
+	switch len(idParts) {
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	case 2:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	default:
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: vdc.disk_id or disk_id. Got: %q", req.ID),
+		)
+	}
+}
+
+// read returns the state of the disk. found is false if the disk does not exist.
+func (r *diskResource) read(_ context.Context, rm *diskResourceModel) (state *diskResourceModel, found bool, diags diag.Diagnostics) {
+	disk, err := r.vdc.GetDiskById(rm.ID.ValueString(), true)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving disk", err.Error())
+		return nil, true, diags
+	}
+
+	state = &diskResourceModel{
+		ID:             types.StringValue(disk.Disk.Id),
+		VDC:            types.StringValue(r.vdc.GetName()),
+		Name:           types.StringValue(disk.Disk.Name),
+		Description:    types.StringValue(disk.Disk.Description),
+		SizeInMb:       types.Int64Value(disk.Disk.SizeMb),
+		StorageProfile: types.StringNull(),
+		BusType:        types.StringValue(diskparams.GetBusTypeByCode(disk.Disk.BusType, disk.Disk.BusSubType).Name()),
+		SharingType:    types.StringValue(disk.Disk.SharingType),
+		IOPS:           types.Int64Null(),
+	}
+
+	if disk.Disk.StorageProfile != nil {
+		state.StorageProfile = types.StringValue(disk.Disk.StorageProfile.Name)
+	}
+
+	if disk.Disk.Iops != nil {
+		state.IOPS = types.Int64Value(int64(*disk.Disk.Iops))
+	}
+
+	return state, true, nil
+}
@@ -0,0 +1,201 @@
+package disk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm/diskparams"
+)
+
+/*
+diskSchema
+
+This function is used to create the schema for the disk resource.
+The attributes (except vdc) are also used to describe the disks of the disks data source.
+*/
+func diskSchema() superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The disk resource allows you to manage an independent disk in a vDC. The disk is attached to a VM with the `cloudavenue_vm_disk_attachment` resource, so it can be moved from a VM to another without being destroyed.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the disk.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"vdc": vdc.SuperSchema(),
+			"name": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the disk.",
+				},
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name must be unique in the vDC.",
+					Required:            true,
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"description": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The description of the disk.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(""),
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"size_in_mb": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The size of the disk in MB.",
+				},
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: "The disk must be detached from the VM to be resized.",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					Computed: true,
+				},
+			},
+			"storage_profile": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the storage profile.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "If not set, the default storage profile of the vDC is used. The disk must be detached from the VM to change its storage profile.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.OneOf(diskparams.StorageProfileValues...),
+					},
+				},
+			},
+			"bus_type": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The type of disk controller.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Default:  stringdefault.StaticString(diskparams.BusTypeSCSI.Name()),
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						stringvalidator.OneOf(diskparams.BusTypeSCSI.Name(), diskparams.BusTypeSATA.Name(), diskparams.BusTypeNVME.Name()),
+					},
+				},
+			},
+			"sharing_type": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The sharing type of the disk. `None` means the disk can be attached to a single VM.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Default:  stringdefault.StaticString(sharingTypeNone),
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						stringvalidator.OneOf(sharingTypes...),
+					},
+				},
+			},
+			"iops": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The IOPS limit of the disk.",
+					Computed:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: "If not set, the IOPS limit is defined by the storage profile.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+						int64planmodifier.RequiresReplace(),
+					},
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+			},
+		},
+	}
+}
+
+/*
+disksSchema
+
+This function is used to create the schema for the disks data source.
+*/
+func disksSchema() superschema.Schema {
+	diskAttributes := diskSchema().Attributes
+	delete(diskAttributes, "vdc")
+
+	diskAttributes["is_attached"] = superschema.BoolAttribute{
+		DataSource: &schemaD.BoolAttribute{
+			MarkdownDescription: "Whether the disk is attached to a VM.",
+			Computed:            true,
+		},
+	}
+	diskAttributes["attached_vm_ids"] = superschema.SetAttribute{
+		DataSource: &schemaD.SetAttribute{
+			MarkdownDescription: "The IDs of the VMs to which the disk is attached.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+
+	return superschema.Schema{
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "The disks data source allows you to list the independent disks of a vDC and their attach state.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The ID of the disks data source.",
+					Computed:            true,
+				},
+			},
+			"vdc": vdc.SuperSchema(),
+			"disks": superschema.ListNestedAttribute{
+				DataSource: &schemaD.ListNestedAttribute{
+					MarkdownDescription: "The list of the independent disks.",
+					Computed:            true,
+				},
+				Attributes: diskAttributes,
+			},
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package disk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type diskResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	VDC            types.String `tfsdk:"vdc"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	SizeInMb       types.Int64  `tfsdk:"size_in_mb"`
+	StorageProfile types.String `tfsdk:"storage_profile"`
+	BusType        types.String `tfsdk:"bus_type"`
+	SharingType    types.String `tfsdk:"sharing_type"`
+	IOPS           types.Int64  `tfsdk:"iops"`
+}
+
+type disksDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	VDC   types.String `tfsdk:"vdc"`
+	Disks types.List   `tfsdk:"disks"`
+}
+
+type disksDataSourceModelDisk struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	SizeInMb       types.Int64  `tfsdk:"size_in_mb"`
+	StorageProfile types.String `tfsdk:"storage_profile"`
+	BusType        types.String `tfsdk:"bus_type"`
+	SharingType    types.String `tfsdk:"sharing_type"`
+	IOPS           types.Int64  `tfsdk:"iops"`
+	IsAttached     types.Bool   `tfsdk:"is_attached"`
+	AttachedVMIDs  types.Set    `tfsdk:"attached_vm_ids"`
+}
+
+var disksDataSourceModelDiskAttrTypes = map[string]attr.Type{
+	"id":              types.StringType,
+	"name":            types.StringType,
+	"description":     types.StringType,
+	"size_in_mb":      types.Int64Type,
+	"storage_profile": types.StringType,
+	"bus_type":        types.StringType,
+	"sharing_type":    types.StringType,
+	"iops":            types.Int64Type,
+	"is_attached":     types.BoolType,
+	"attached_vm_ids": types.SetType{ElemType: types.StringType},
+}
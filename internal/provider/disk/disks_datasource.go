@@ -0,0 +1,160 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm/diskparams"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+const mimeDisk = "application/vnd.vmware.vcloud.disk+xml"
+
+var (
+	_ datasource.DataSource              = &disksDataSource{}
+	_ datasource.DataSourceWithConfigure = &disksDataSource{}
+)
+
+// NewDisksDataSource returns a new resource implementing the disks data source.
+func NewDisksDataSource() datasource.DataSource {
+	return &disksDataSource{}
+}
+
+type disksDataSource struct {
+	client *client.CloudAvenue
+	vdc    vdc.VDC
+}
+
+func (d *disksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "s"
+}
+
+func (d *disksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = disksSchema().GetDataSource(ctx)
+}
+
+func (d *disksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *disksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	config := &disksDataSourceModel{}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	d.vdc, diags = vdc.Init(d.client, config.VDC)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := d.vdc.Refresh(); err != nil {
+		resp.Diagnostics.AddError("Error refreshing vDC", err.Error())
+		return
+	}
+
+	var (
+		disks = make([]disksDataSourceModelDisk, 0)
+		ids   = make([]string, 0)
+	)
+
+	for _, resourceEntities := range d.vdc.Vdc.Vdc.ResourceEntities {
+		for _, resourceEntity := range resourceEntities.ResourceEntity {
+			if resourceEntity.Type != mimeDisk {
+				continue
+			}
+
+			disk, err := d.vdc.GetDiskByHref(resourceEntity.HREF)
+			if err != nil {
+				resp.Diagnostics.AddError("Error retrieving disk", err.Error())
+				return
+			}
+
+			attachedVMsHREFs, err := disk.GetAttachedVmsHrefs()
+			if err != nil {
+				resp.Diagnostics.AddError("Error retrieving attached VMs", err.Error())
+				return
+			}
+
+			attachedVMIDs := make([]string, 0, len(attachedVMsHREFs))
+			for _, href := range attachedVMsHREFs {
+				vm, err := d.client.Vmware.Client.GetVMByHref(href)
+				if err != nil {
+					resp.Diagnostics.AddError("Error retrieving attached VM", err.Error())
+					return
+				}
+				attachedVMIDs = append(attachedVMIDs, vm.VM.ID)
+			}
+
+			x := disksDataSourceModelDisk{
+				ID:             types.StringValue(disk.Disk.Id),
+				Name:           types.StringValue(disk.Disk.Name),
+				Description:    types.StringValue(disk.Disk.Description),
+				SizeInMb:       types.Int64Value(disk.Disk.SizeMb),
+				StorageProfile: types.StringNull(),
+				BusType:        types.StringValue(diskparams.GetBusTypeByCode(disk.Disk.BusType, disk.Disk.BusSubType).Name()),
+				SharingType:    types.StringValue(disk.Disk.SharingType),
+				IOPS:           types.Int64Null(),
+				IsAttached:     types.BoolValue(len(attachedVMIDs) > 0),
+			}
+
+			if disk.Disk.StorageProfile != nil {
+				x.StorageProfile = types.StringValue(disk.Disk.StorageProfile.Name)
+			}
+
+			if disk.Disk.Iops != nil {
+				x.IOPS = types.Int64Value(int64(*disk.Disk.Iops))
+			}
+
+			x.AttachedVMIDs, diags = types.SetValueFrom(ctx, types.StringType, attachedVMIDs)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			disks = append(disks, x)
+			ids = append(ids, disk.Disk.Id)
+		}
+	}
+
+	state := &disksDataSourceModel{
+		ID:  utils.GenerateUUID(ids),
+		VDC: types.StringValue(d.vdc.GetName()),
+	}
+
+	state.Disks, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: disksDataSourceModelDiskAttrTypes}, disks)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -237,10 +237,16 @@ func (r *dhcpForwardingResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -342,10 +348,16 @@ func (r *dhcpForwardingResource) createOrUpdate(ctx context.Context, plan *DhcpF
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+			diags.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); errLock != nil {
+			diags.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
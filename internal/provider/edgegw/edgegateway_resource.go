@@ -29,6 +29,8 @@ const (
 	defaultCheckJobDelayEdgeGateway = 10 * time.Second
 )
 
+var errEdgeGatewayNotFound = errors.New("edge gateway not found after the create action")
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &edgeGatewaysResource{}
@@ -143,8 +145,11 @@ func (r *edgeGatewaysResource) Create(
 		EnableLoadBalancing: plan.EnableLoadBalancing.ValueBool(),
 	}
 
-	cloudavenue.Lock(ctx)
-	defer cloudavenue.Unlock(ctx)
+	if errLock := cloudavenue.Lock(ctxTO, cloudavenue.KeyOrg); errLock != nil {
+		resp.Diagnostics.AddError("Error locking the Cloud Avenue API", errLock.Error())
+		return
+	}
+	defer cloudavenue.Unlock(ctx, cloudavenue.KeyOrg)
 
 	var err error
 	var job apiclient.Jobcreated
@@ -207,45 +212,25 @@ func (r *edgeGatewaysResource) Create(
 	}
 
 	// Wait for job to complete
-	errRetry := retry.RetryContext(ctxTO, createTimeout, func() *retry.RetryError {
-		jobStatus, errGetJob := helpers.GetJobStatus(auth, r.client, job.JobId)
-		if errGetJob != nil {
-			return retry.NonRetryableError(errGetJob)
-		}
-		if !slices.Contains(helpers.JobStateDone(), jobStatus.String()) {
-			return retry.RetryableError(fmt.Errorf("expected job done but was %s", jobStatus))
+	if errJob := helpers.WaitForJob(auth, r.client, job.JobId, createTimeout); errJob != nil {
+		if errors.Is(errJob, helpers.ErrJobStillRunning) {
+			// The edge gateway is still being created, keep track of the job to resume it on the next refresh.
+			plan.ID = types.StringNull()
+			plan.Name = types.StringNull()
+			plan.Description = types.StringNull()
+			resp.Diagnostics.Append(helpers.SetPendingJob(ctx, resp.Private, job.JobId)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.Append(helpers.NewJobStillRunningWarning("edge gateway", job.JobId))
+			return
 		}
-
-		return nil
-	})
-
-	if errRetry != nil {
-		resp.Diagnostics.AddError("Error waiting job to complete", errRetry.Error())
+		resp.Diagnostics.AddError("Error waiting job to complete", errJob.Error())
 		return
 	}
-	// Job done, retrieve edge gateway
-	// get all edge gateways and find the one that matches the tier0_vrf_id and owner_name
-	gateways, httpRc, errEdgesGet := r.client.APIClient.EdgeGatewaysApi.GetEdges(auth)
-	if httpRc != nil {
-		defer func() {
-			err = errors.Join(err, httpRc.Body.Close())
-		}()
-	}
-	if apiErr := helpers.CheckAPIError(errEdgesGet, httpRc); apiErr != nil {
-		resp.Diagnostics.Append(apiErr.GetTerraformDiagnostic())
-		return
-	}
-
-	var newEdgeGW apiclient.EdgeGateway
-	for _, gw := range gateways {
-		if gw.Tier0VrfId == plan.Tier0VrfID.ValueString() && gw.OwnerName == plan.OwnerName.ValueString() {
-			newEdgeGW = gw
-			break
-		}
-	}
 
-	if newEdgeGW == (apiclient.EdgeGateway{}) {
-		resp.Diagnostics.AddError("Error retrieving edge gateway", "edge gateway not found after the create action")
+	// Job done, retrieve edge gateway
+	newEdgeGW, err := r.findCreatedEdgeGateway(auth, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving edge gateway", err.Error())
 		return
 	}
 
@@ -304,9 +289,33 @@ func (r *edgeGatewaysResource) Read(
 		return
 	}
 
+	// Resume the creation job interrupted during a previous apply
+	jobStatus, d := helpers.ResumePendingJob(auth, r.client, resp.Private, readTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || jobStatus == helpers.INPROGRESS {
+		return
+	}
+	if jobStatus == helpers.FAILED {
+		// The edge gateway has not been created, remove it from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	var gateway apiclient.EdgeGateway
 	// Get edge gateway
-	if !state.ID.IsNull() {
+	if state.ID.IsNull() && state.Name.IsNull() {
+		// The creation has been interrupted, retrieve the edge gateway created by the job
+		var err error
+		gateway, err = r.findCreatedEdgeGateway(auth, state)
+		if err != nil {
+			if errors.Is(err, errEdgeGatewayNotFound) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("Error retrieving edge gateway", err.Error())
+			return
+		}
+	} else if !state.ID.IsNull() {
 		var (
 			httpR *http.Response
 			err   error
@@ -481,9 +490,6 @@ func (r *edgeGatewaysResource) Delete(
 		return
 	}
 
-	cloudavenue.Lock(ctx)
-	defer cloudavenue.Unlock(ctx)
-
 	// Delete timeout
 	deleteTimeout, errTO := state.Timeouts.Delete(ctx, 8*time.Minute)
 	if errTO != nil {
@@ -505,6 +511,40 @@ func (r *edgeGatewaysResource) Delete(
 		)
 		return
 	}
+
+	if errLock := cloudavenue.Lock(ctxTO, cloudavenue.KeyVDC(state.OwnerName.ValueString())); errLock != nil {
+		resp.Diagnostics.AddError("Error locking the Cloud Avenue API", errLock.Error())
+		return
+	}
+	defer cloudavenue.Unlock(ctx, cloudavenue.KeyVDC(state.OwnerName.ValueString()))
+
+	// Wait for the creation job interrupted during a previous apply
+	jobStatus, d := helpers.ResumePendingJob(auth, r.client, req.Private, deleteTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	switch jobStatus {
+	case helpers.FAILED:
+		// The edge gateway has not been created, nothing to delete
+		return
+	case helpers.INPROGRESS:
+		resp.Diagnostics.AddError("Error deleting edge gateway", "The edge gateway is still being created, it cannot be deleted yet.")
+		return
+	}
+
+	if state.ID.IsNull() {
+		// The state has not been refreshed since the creation has been interrupted
+		gateway, err := r.findCreatedEdgeGateway(auth, &state)
+		if err != nil {
+			if !errors.Is(err, errEdgeGatewayNotFound) {
+				resp.Diagnostics.AddError("Error retrieving edge gateway", err.Error())
+			}
+			return
+		}
+		state.ID = types.StringValue(uuid.Normalize(uuid.Gateway, gateway.EdgeId).String())
+	}
+
 	// Delete the edge gateway
 	job, httpR, err := r.client.APIClient.EdgeGatewaysApi.DeleteEdge(
 		auth,
@@ -540,6 +580,28 @@ func (r *edgeGatewaysResource) Delete(
 	}
 }
 
+// findCreatedEdgeGateway returns the edge gateway attached to the Tier-0 VRF and owned by the vDC or vDC group of the model.
+func (r *edgeGatewaysResource) findCreatedEdgeGateway(ctx context.Context, model *edgeGatewaysResourceModel) (gateway apiclient.EdgeGateway, err error) {
+	// get all edge gateways and find the one that matches the tier0_vrf_id and owner_name
+	gateways, httpR, err := r.client.APIClient.EdgeGatewaysApi.GetEdges(ctx)
+	if httpR != nil {
+		defer func() {
+			err = errors.Join(err, httpR.Body.Close())
+		}()
+	}
+	if apiErr := helpers.CheckAPIError(err, httpR); apiErr != nil {
+		return gateway, apiErr
+	}
+
+	for _, gw := range gateways {
+		if gw.Tier0VrfId == model.Tier0VrfID.ValueString() && gw.OwnerName == model.OwnerName.ValueString() {
+			return gw, nil
+		}
+	}
+
+	return gateway, errEdgeGatewayNotFound
+}
+
 func (r *edgeGatewaysResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
@@ -15,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers"
 )
 
 /*
@@ -28,7 +30,7 @@ func edgegwSchema() superschema.Schema {
 			MarkdownDescription: "The Edge Gateway ",
 		},
 		Resource: superschema.SchemaDetails{
-			MarkdownDescription: "resource allows you to create and delete Edge Gateways in Cloud Avenue.",
+			MarkdownDescription: "resource allows you to create and delete Edge Gateways in Cloud Avenue.\n\n" + helpers.PendingJobNote,
 		},
 		DataSource: superschema.SchemaDetails{
 			MarkdownDescription: "data source allows you to show the details of an Edge Gateways in Cloud Avenue.",
@@ -54,72 +54,84 @@ func (rm *firewallModel) RulesFromPlan(ctx context.Context) (rules firewallModel
 
 // rulesToNsxtFirewallRule.
 func (rules *firewallModelRules) rulesToNsxtFirewallRule(ctx context.Context) (nsxtFirewallRules []*govcdtypes.NsxtFirewallRule, diags diag.Diagnostics) {
-	nsxtFirewallRules = make([]*govcdtypes.NsxtFirewallRule, len(*rules))
-	for i, rule := range *rules {
-		nsxtFirewallRules[i] = &govcdtypes.NsxtFirewallRule{
-			Name:       rule.Name.ValueString(),
-			Action:     rule.Action.ValueString(),
-			Enabled:    rule.Enabled.ValueBool(),
-			IpProtocol: rule.IPProtocol.ValueString(),
-			Logging:    rule.Logging.ValueBool(),
-			Direction:  rule.Direction.ValueString(),
-			Version:    nil,
-		}
-
-		// ! If sourceIDs/destinationIDs is Null, it's an equivalent of any (source/destination)
-
-		// * sourceIDs
-		if !rule.SourceIDs.IsNull() {
-			nsxtFirewallRules[i].SourceFirewallGroups = make([]govcdtypes.OpenApiReference, 0)
-			sourcesIDs := make([]string, 0)
-			if d := rule.SourceIDs.ElementsAs(ctx, &sourcesIDs, false); d.HasError() {
-				diags.Append(d...)
-				return
-			}
-
-			for index, sourceID := range sourcesIDs {
-				nsxtFirewallRules[i].SourceFirewallGroups[index] = govcdtypes.OpenApiReference{
-					ID: sourceID,
-				}
-			}
+	nsxtFirewallRules = make([]*govcdtypes.NsxtFirewallRule, 0, len(*rules))
+	for _, rule := range *rules {
+		nsxtFirewallRule, d := rule.toNsxtFirewallRule(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
 		}
+		nsxtFirewallRules = append(nsxtFirewallRules, nsxtFirewallRule)
+	}
 
-		// * destinationIDs
-		if !rule.DestinationIDs.IsNull() {
-			nsxtFirewallRules[i].DestinationFirewallGroups = make([]govcdtypes.OpenApiReference, 0)
-			destinationIDs := make([]string, 0)
-			if d := rule.DestinationIDs.ElementsAs(ctx, &destinationIDs, false); d.HasError() {
-				diags.Append(d...)
-				return
-			}
-
-			for index, destinationID := range destinationIDs {
-				nsxtFirewallRules[i].DestinationFirewallGroups[index] = govcdtypes.OpenApiReference{
-					ID: destinationID,
-				}
-			}
-		}
+	return
+}
 
-		// * appPortProfilesIDs
-		if !rule.AppPortProfileIDs.IsNull() {
-			nsxtFirewallRules[i].ApplicationPortProfiles = make([]govcdtypes.OpenApiReference, 0)
-			appPortProfilesIDs := make([]string, 0)
-			if d := rule.AppPortProfileIDs.ElementsAs(ctx, &appPortProfilesIDs, false); d.HasError() {
-				diags.Append(d...)
-				return
-			}
-
-			for index, appPortProfileID := range appPortProfilesIDs {
-				nsxtFirewallRules[i].ApplicationPortProfiles[index] = govcdtypes.OpenApiReference{
-					ID: appPortProfileID,
-				}
-			}
-		}
+// toNsxtFirewallRule converts a rule to a NSX-T firewall rule.
+func (rule firewallModelRule) toNsxtFirewallRule(ctx context.Context) (nsxtFirewallRule *govcdtypes.NsxtFirewallRule, diags diag.Diagnostics) {
+	nsxtFirewallRule = &govcdtypes.NsxtFirewallRule{
+		ID:         rule.ID.ValueString(),
+		Name:       rule.Name.ValueString(),
+		Action:     rule.Action.ValueString(),
+		Enabled:    rule.Enabled.ValueBool(),
+		IpProtocol: rule.IPProtocol.ValueString(),
+		Logging:    rule.Logging.ValueBool(),
+		Direction:  rule.Direction.ValueString(),
+		Version:    nil,
 	}
 
+	// ! If sourceIDs/destinationIDs is Null, it's an equivalent of any (source/destination)
+	var d diag.Diagnostics
+
+	// * sourceIDs
+	nsxtFirewallRule.SourceFirewallGroups, d = setToOpenAPIReferences(ctx, rule.SourceIDs)
+	diags.Append(d...)
+
+	// * destinationIDs
+	nsxtFirewallRule.DestinationFirewallGroups, d = setToOpenAPIReferences(ctx, rule.DestinationIDs)
+	diags.Append(d...)
+
+	// * appPortProfilesIDs
+	nsxtFirewallRule.ApplicationPortProfiles, d = setToOpenAPIReferences(ctx, rule.AppPortProfileIDs)
+	diags.Append(d...)
+
 	return
 }
 
+// setToOpenAPIReferences converts a set of IDs to OpenAPI references. A nil slice is returned if the set is null or unknown.
+func setToOpenAPIReferences(ctx context.Context, set types.Set) (refs []govcdtypes.OpenApiReference, diags diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+
+	ids := make([]string, 0)
+	diags.Append(set.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	refs = make([]govcdtypes.OpenApiReference, len(ids))
+	for i, id := range ids {
+		refs[i] = govcdtypes.OpenApiReference{ID: id}
+	}
+
+	return refs, diags
+}
+
+// openAPIReferencesToSet converts OpenAPI references to a set of IDs. A null set is returned if there is no reference.
+func openAPIReferencesToSet(ctx context.Context, refs []govcdtypes.OpenApiReference) (types.Set, diag.Diagnostics) {
+	if len(refs) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		ids[i] = ref.ID
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, ids)
+}
+
 // attrTypes returns the attribute types for the resource.
 func (rules *firewallModelRules) AttrTypes(_ context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
@@ -158,32 +170,42 @@ func fwRulesRead(ctx context.Context, fwRules *govcd.NsxtFirewall) (rules firewa
 	rules = make(firewallModelRules, 0)
 
 	for _, rule := range fwRules.NsxtFirewallRuleContainer.UserDefinedRules {
-		sourceIDs, d := types.SetValueFrom(ctx, types.StringType, rule.SourceFirewallGroups)
-		diags.Append(d...)
-		destinationIDs, d := types.SetValueFrom(ctx, types.StringType, rule.DestinationFirewallGroups)
-		diags.Append(d...)
-		appPortProfileIDs, d := types.SetValueFrom(ctx, types.StringType, rule.ApplicationPortProfiles)
+		r, d := fwRuleRead(ctx, rule)
 		diags.Append(d...)
 		if diags.HasError() {
 			return
 		}
-		rules = append(rules, firewallModelRule{
-			ID:                types.StringValue(rule.ID),
-			Name:              types.StringValue(rule.Name),
-			Enabled:           types.BoolValue(rule.Enabled),
-			Direction:         types.StringValue(rule.Direction),
-			IPProtocol:        types.StringValue(rule.IpProtocol),
-			Action:            types.StringValue(rule.Action),
-			Logging:           types.BoolValue(rule.Logging),
-			SourceIDs:         sourceIDs,
-			DestinationIDs:    destinationIDs,
-			AppPortProfileIDs: appPortProfileIDs,
-		})
+		rules = append(rules, r)
 	}
 
 	return
 }
 
+// fwRuleRead converts a NSX-T firewall rule to a rule.
+func fwRuleRead(ctx context.Context, rule *govcdtypes.NsxtFirewallRule) (firewallModelRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sourceIDs, d := openAPIReferencesToSet(ctx, rule.SourceFirewallGroups)
+	diags.Append(d...)
+	destinationIDs, d := openAPIReferencesToSet(ctx, rule.DestinationFirewallGroups)
+	diags.Append(d...)
+	appPortProfileIDs, d := openAPIReferencesToSet(ctx, rule.ApplicationPortProfiles)
+	diags.Append(d...)
+
+	return firewallModelRule{
+		ID:                types.StringValue(rule.ID),
+		Name:              types.StringValue(rule.Name),
+		Enabled:           types.BoolValue(rule.Enabled),
+		Direction:         types.StringValue(rule.Direction),
+		IPProtocol:        types.StringValue(rule.IpProtocol),
+		Action:            types.StringValue(rule.Action),
+		Logging:           types.BoolValue(rule.Logging),
+		SourceIDs:         sourceIDs,
+		DestinationIDs:    destinationIDs,
+		AppPortProfileIDs: appPortProfileIDs,
+	}, diags
+}
+
 // Init Initializes the resource.
 func (r *firewallResource) Init(ctx context.Context, rm *firewallModel) (diags diag.Diagnostics) {
 	var err error
@@ -267,9 +289,19 @@ func (r *firewallResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
+	// The per-rule resource locks the Edge Gateway, the whole rule list is replaced here.
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
 	// Set the rules
 	vcdRules, d := rules.rulesToNsxtFirewallRule(ctx)
 	resp.Diagnostics.Append(d...)
@@ -357,9 +389,18 @@ func (r *firewallResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
 	vcdRules, d := rules.rulesToNsxtFirewallRule(ctx)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
@@ -409,9 +450,18 @@ func (r *firewallResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
 	fwRules, err := r.edgegw.GetNsxtFirewall()
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving Edge Gateway Firewall", err.Error())
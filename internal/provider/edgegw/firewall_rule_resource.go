@@ -0,0 +1,523 @@
+package edgegw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &firewallRuleResource{}
+	_ resource.ResourceWithConfigure   = &firewallRuleResource{}
+	_ resource.ResourceWithImportState = &firewallRuleResource{}
+)
+
+// firewallRulesMaxAttempts is the number of attempts to update the rules of a firewall changed concurrently.
+const firewallRulesMaxAttempts = 3
+
+// ErrFirewallRulesConflict is returned when the rules of a firewall keep changing during an update.
+var ErrFirewallRulesConflict = errors.New("the firewall rules are changed concurrently, retry later")
+
+// NewFirewallRuleResource is a helper function to simplify the provider implementation.
+func NewFirewallRuleResource() resource.Resource {
+	return &firewallRuleResource{}
+}
+
+// firewallRuleResource is the resource implementation.
+type firewallRuleResource struct {
+	client *client.CloudAvenue
+	org    org.Org
+	edgegw edgegw.EdgeGateway
+}
+
+// Init Initializes the resource.
+func (r *firewallRuleResource) Init(ctx context.Context, rm *firewallRuleModel) (diags diag.Diagnostics) {
+	var err error
+
+	r.org, diags = org.Init(r.client)
+	if diags.HasError() {
+		return
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   rm.EdgeGatewayID,
+		Name: rm.EdgeGatewayName,
+	})
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway", err.Error())
+		return
+	}
+
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *firewallRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_firewall_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *firewallRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = firewallRuleSchema(ctx).GetResource(ctx)
+}
+
+func (r *firewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *firewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &firewallRuleModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	rule, d := plan.toFirewallModelRule().toNsxtFirewallRule(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rule.ID = ""
+
+	index := 0
+	created, err := r.updateRules(func(rules []*govcdtypes.NsxtFirewallRule) ([]*govcdtypes.NsxtFirewallRule, error) {
+		var errIndex error
+		index, errIndex = firewallRuleIndex(rules, plan.BeforeRuleID.ValueString(), plan.AfterRuleID.ValueString())
+		if errIndex != nil {
+			return nil, errIndex
+		}
+		return insertFirewallRule(rules, index, rule), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating firewall rule", err.Error())
+		return
+	}
+
+	// The rules are returned in the same order, the new rule is at the requested position.
+	if index >= len(created) || created[index].Name != rule.Name {
+		resp.Diagnostics.AddError("Error creating firewall rule", fmt.Sprintf("unable to find the rule %s after its creation", rule.Name))
+		return
+	}
+	plan.ID = types.StringValue(created[index].ID)
+
+	state, found, d := r.read(ctx, plan)
+	if !found {
+		resp.Diagnostics.AddError("Error retrieving firewall rule", "The firewall rule is not found after its creation")
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *firewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &firewallRuleModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRefreshed, found, d := r.read(ctx, state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *firewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		plan  = &firewallRuleModel{}
+		state = &firewallRuleModel{}
+	)
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	rule, d := plan.toFirewallModelRule().toNsxtFirewallRule(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.updateRules(func(rules []*govcdtypes.NsxtFirewallRule) ([]*govcdtypes.NsxtFirewallRule, error) {
+		index := -1
+		for i, existing := range rules {
+			if existing.ID == state.ID.ValueString() {
+				index = i
+				rule.Version = existing.Version
+				break
+			}
+		}
+		if index == -1 {
+			return nil, fmt.Errorf("firewall rule %s not found", state.ID.ValueString())
+		}
+
+		if plan.BeforeRuleID.Equal(state.BeforeRuleID) && plan.AfterRuleID.Equal(state.AfterRuleID) {
+			rules[index] = rule
+			return rules, nil
+		}
+
+		// The rule is moved to its new position.
+		rules = append(rules[:index], rules[index+1:]...)
+		index, err := firewallRuleIndex(rules, plan.BeforeRuleID.ValueString(), plan.AfterRuleID.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		return insertFirewallRule(rules, index, rule), nil
+	}); err != nil {
+		resp.Diagnostics.AddError("Error updating firewall rule", err.Error())
+		return
+	}
+
+	stateRefreshed, found, d := r.read(ctx, plan)
+	if !found {
+		resp.Diagnostics.AddError("Error retrieving firewall rule", "The firewall rule is not found after its update")
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *firewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &firewallRuleModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	_, found, d := r.read(ctx, state)
+	if !found {
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fwRules, err := r.edgegw.GetNsxtFirewall()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Edge Gateway Firewall", err.Error())
+		return
+	}
+
+	if err := fwRules.DeleteRuleById(state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting firewall rule", err.Error())
+	}
+}
+
+func (r *firewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format : edgeGatewayIDOrName.ruleIDOrName
+	idParts := strings.Split(req.ID, ".")
+	if len(idParts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: edgeGatewayIDOrName.ruleIDOrName. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var (
+		edgegwID   string
+		edgegwName string
+		d          diag.Diagnostics
+	)
+
+	r.org, d = org.Init(r.client)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	if uuid.IsValid(idParts[0]) {
+		edgegwID = uuid.Normalize(uuid.Gateway, idParts[0]).String()
+	} else {
+		edgegwName = idParts[0]
+	}
+
+	edgegw, err := r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(edgegwID),
+		Name: types.StringValue(edgegwName),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import firewall rule.", err.Error())
+		return
+	}
+
+	fwRules, err := edgegw.GetNsxtFirewall()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Edge Gateway Firewall", err.Error())
+		return
+	}
+
+	var ruleID string
+	for _, rule := range fwRules.NsxtFirewallRuleContainer.UserDefinedRules {
+		if rule.ID == idParts[1] || rule.Name == idParts[1] {
+			ruleID = rule.ID
+			break
+		}
+	}
+	if ruleID == "" {
+		resp.Diagnostics.AddError("Failed to import firewall rule.", fmt.Sprintf("firewall rule %s not found", idParts[1]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ruleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_id"), edgegw.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), edgegw.GetName())...)
+}
+
+// read returns the rule of the firewall. found is false if the rule does not exist.
+// The position attributes are kept from the given model.
+func (r *firewallRuleResource) read(ctx context.Context, rm *firewallRuleModel) (state *firewallRuleModel, found bool, diags diag.Diagnostics) {
+	fwRules, err := r.edgegw.GetNsxtFirewall()
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway Firewall", err.Error())
+		return nil, true, diags
+	}
+
+	for _, rule := range fwRules.NsxtFirewallRuleContainer.UserDefinedRules {
+		if rule.ID != rm.ID.ValueString() {
+			continue
+		}
+
+		fr, d := fwRuleRead(ctx, rule)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, true, diags
+		}
+
+		return &firewallRuleModel{
+			ID:                fr.ID,
+			EdgeGatewayID:     types.StringValue(r.edgegw.GetID()),
+			EdgeGatewayName:   types.StringValue(r.edgegw.GetName()),
+			Name:              fr.Name,
+			Enabled:           fr.Enabled,
+			Direction:         fr.Direction,
+			IPProtocol:        fr.IPProtocol,
+			Action:            fr.Action,
+			Logging:           fr.Logging,
+			SourceIDs:         fr.SourceIDs,
+			DestinationIDs:    fr.DestinationIDs,
+			AppPortProfileIDs: fr.AppPortProfileIDs,
+			BeforeRuleID:      rm.BeforeRuleID,
+			AfterRuleID:       rm.AfterRuleID,
+		}, true, diags
+	}
+
+	return nil, false, nil
+}
+
+// updateRules updates the rules of the firewall with change, which returns the new list of rules
+// from the current one. See updateFirewallRules.
+func (r *firewallRuleResource) updateRules(change func([]*govcdtypes.NsxtFirewallRule) ([]*govcdtypes.NsxtFirewallRule, error)) ([]*govcdtypes.NsxtFirewallRule, error) {
+	return updateFirewallRules(
+		func() ([]*govcdtypes.NsxtFirewallRule, error) {
+			fwRules, err := r.edgegw.GetNsxtFirewall()
+			if err != nil {
+				return nil, fmt.Errorf("error retrieving Edge Gateway Firewall: %w", err)
+			}
+			return fwRules.NsxtFirewallRuleContainer.UserDefinedRules, nil
+		},
+		func(rules []*govcdtypes.NsxtFirewallRule) ([]*govcdtypes.NsxtFirewallRule, error) {
+			fwRules, err := r.edgegw.UpdateNsxtFirewall(&govcdtypes.NsxtFirewallRuleContainer{
+				UserDefinedRules: rules,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return fwRules.NsxtFirewallRuleContainer.UserDefinedRules, nil
+		},
+		change,
+	)
+}
+
+// updateFirewallRules updates the rules of a firewall, which are only written as a whole list.
+// The lock of the edge gateway only serializes the updates of this provider process, the rules
+// may be changed by another Terraform configuration or by the Cloud Avenue portal between the
+// read and the write. The rules are read again right before the write, and the change is computed
+// again from the new rules if they have changed. ErrFirewallRulesConflict is returned if the rules
+// keep changing. A rule added by someone else in the short time between the last read and the
+// write is still lost.
+func updateFirewallRules(
+	get func() ([]*govcdtypes.NsxtFirewallRule, error),
+	put func([]*govcdtypes.NsxtFirewallRule) ([]*govcdtypes.NsxtFirewallRule, error),
+	change func([]*govcdtypes.NsxtFirewallRule) ([]*govcdtypes.NsxtFirewallRule, error),
+) ([]*govcdtypes.NsxtFirewallRule, error) {
+	base, err := get()
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; attempt < firewallRulesMaxAttempts; attempt++ {
+		rules, err := change(append([]*govcdtypes.NsxtFirewallRule(nil), base...))
+		if err != nil {
+			return nil, err
+		}
+
+		current, err := get()
+		if err != nil {
+			return nil, err
+		}
+		if sameFirewallRules(base, current) {
+			return put(rules)
+		}
+
+		base = current
+	}
+
+	return nil, ErrFirewallRulesConflict
+}
+
+// sameFirewallRules returns true if both lists contain the same rules, in the same order and at the same version.
+func sameFirewallRules(a, b []*govcdtypes.NsxtFirewallRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].ID != b[i].ID || firewallRuleVersion(a[i]) != firewallRuleVersion(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// firewallRuleVersion returns the version of a rule, or -1 if the version is not known.
+func firewallRuleVersion(rule *govcdtypes.NsxtFirewallRule) int {
+	if rule.Version == nil || rule.Version.Version == nil {
+		return -1
+	}
+	return *rule.Version.Version
+}
+
+// firewallRuleIndex returns the index where a rule must be inserted in the list of rules.
+// The rule is placed before beforeID or after afterID, or at the end of the list if both are empty.
+func firewallRuleIndex(rules []*govcdtypes.NsxtFirewallRule, beforeID, afterID string) (int, error) {
+	if beforeID == "" && afterID == "" {
+		return len(rules), nil
+	}
+
+	for i, rule := range rules {
+		switch rule.ID {
+		case beforeID:
+			return i, nil
+		case afterID:
+			return i + 1, nil
+		}
+	}
+
+	if beforeID != "" {
+		return 0, fmt.Errorf("firewall rule %s (before_rule_id) not found", beforeID)
+	}
+	return 0, fmt.Errorf("firewall rule %s (after_rule_id) not found", afterID)
+}
+
+// insertFirewallRule inserts a rule in the list of rules at the given index.
+func insertFirewallRule(rules []*govcdtypes.NsxtFirewallRule, index int, rule *govcdtypes.NsxtFirewallRule) []*govcdtypes.NsxtFirewallRule {
+	rules = append(rules, nil)
+	copy(rules[index+1:], rules[index:])
+	rules[index] = rule
+	return rules
+}
@@ -0,0 +1,119 @@
+package edgegw
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// fakeFirewall is the list of rules of a firewall, changed by someone else on each read.
+type fakeFirewall struct {
+	rules []*govcdtypes.NsxtFirewallRule
+	// concurrentChanges is the number of reads after which a rule is added by someone else.
+	concurrentChanges int
+	reads             int
+	puts              int
+}
+
+func (f *fakeFirewall) get() ([]*govcdtypes.NsxtFirewallRule, error) {
+	f.reads++
+	if f.reads > 1 && f.concurrentChanges > 0 {
+		f.concurrentChanges--
+		f.rules = append(f.rules, &govcdtypes.NsxtFirewallRule{ID: fmt.Sprintf("other-%d", f.reads)})
+	}
+	return append([]*govcdtypes.NsxtFirewallRule(nil), f.rules...), nil
+}
+
+func (f *fakeFirewall) put(rules []*govcdtypes.NsxtFirewallRule) ([]*govcdtypes.NsxtFirewallRule, error) {
+	f.puts++
+	f.rules = rules
+	return rules, nil
+}
+
+func ruleIDs(rules []*govcdtypes.NsxtFirewallRule) []string {
+	ids := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.ID)
+	}
+	return ids
+}
+
+func TestUpdateFirewallRules(t *testing.T) {
+	addRule := func(rules []*govcdtypes.NsxtFirewallRule) ([]*govcdtypes.NsxtFirewallRule, error) {
+		return insertFirewallRule(rules, 0, &govcdtypes.NsxtFirewallRule{ID: "mine"}), nil
+	}
+
+	tests := []struct {
+		name              string
+		concurrentChanges int
+		wantErr           error
+		wantRules         string
+		wantPuts          int
+	}{
+		{
+			name:      "NoConflict",
+			wantRules: "[mine existing]",
+			wantPuts:  1,
+		},
+		{
+			name:              "ConcurrentChange",
+			concurrentChanges: 1,
+			wantRules:         "[mine existing other-2]",
+			wantPuts:          1,
+		},
+		{
+			name:              "Conflict",
+			concurrentChanges: firewallRulesMaxAttempts,
+			wantErr:           ErrFirewallRulesConflict,
+			wantRules:         "[existing other-2 other-3 other-4]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fw := &fakeFirewall{
+				rules:             []*govcdtypes.NsxtFirewallRule{{ID: "existing"}},
+				concurrentChanges: tt.concurrentChanges,
+			}
+
+			_, err := updateFirewallRules(fw.get, fw.put, addRule)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			// The rules added by someone else are kept
+			if got := fmt.Sprint(ruleIDs(fw.rules)); got != tt.wantRules {
+				t.Errorf("got rules %s, want %s", got, tt.wantRules)
+			}
+			if fw.puts != tt.wantPuts {
+				t.Errorf("got %d writes, want %d", fw.puts, tt.wantPuts)
+			}
+		})
+	}
+}
+
+func TestSameFirewallRules(t *testing.T) {
+	version := func(v int) *struct {
+		Version *int `json:"version,omitempty"`
+	} {
+		return &struct {
+			Version *int `json:"version,omitempty"`
+		}{Version: &v}
+	}
+
+	a := []*govcdtypes.NsxtFirewallRule{{ID: "1", Version: version(1)}, {ID: "2"}}
+
+	if !sameFirewallRules(a, []*govcdtypes.NsxtFirewallRule{{ID: "1", Version: version(1)}, {ID: "2"}}) {
+		t.Error("same rules: got false")
+	}
+	if sameFirewallRules(a, []*govcdtypes.NsxtFirewallRule{{ID: "1", Version: version(2)}, {ID: "2"}}) {
+		t.Error("updated rule: got true")
+	}
+	if sameFirewallRules(a, []*govcdtypes.NsxtFirewallRule{{ID: "2"}, {ID: "1", Version: version(1)}}) {
+		t.Error("moved rule: got true")
+	}
+	if sameFirewallRules(a, a[:1]) {
+		t.Error("deleted rule: got true")
+	}
+}
@@ -0,0 +1,157 @@
+package edgegw
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+)
+
+func firewallRuleSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The firewall rule resource allows you to manage a single rule of an Edge Gateway firewall. The other rules of the firewall are not modified, so rules can be managed by different Terraform configurations. The list of rules is read again right before it is written, and the change is retried when another client modified it in the meantime. A change made by another client between this last read and the write can still be overwritten. Do not use this resource together with the `cloudavenue_edgegateway_firewall` resource on the same Edge Gateway.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The ID of the rule.",
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"edge_gateway_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"edge_gateway_id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the rule.",
+					Required:            true,
+				},
+			},
+			"direction": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The direction of the rule.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("IN", "OUT", "IN_OUT"),
+					},
+				},
+			},
+			"ip_protocol": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The IP protocol of the rule.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("IPV4"),
+					Validators: []validator.String{
+						stringvalidator.OneOf("IPV4", "IPV6", "IPV4_IPV6"),
+					},
+				},
+			},
+			"action": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "Defines if the rule should `ALLOW` or `DROP` matching traffic.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("ALLOW", "DROP"),
+					},
+				},
+			},
+			"enabled": superschema.BoolAttribute{
+				Resource: &schemaR.BoolAttribute{
+					MarkdownDescription: "Defines if the rule is enabled or not.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(true),
+				},
+			},
+			"logging": superschema.BoolAttribute{
+				Resource: &schemaR.BoolAttribute{
+					MarkdownDescription: "Defines if the rule should log matching traffic.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+			},
+			"source_ids": superschema.SetAttribute{
+				Resource: &schemaR.SetAttribute{
+					MarkdownDescription: "A set of Source Firewall Group IDs (`IP Sets` or `Security Groups`). Leaving it empty means `Any` (all).",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+			},
+			"destination_ids": superschema.SetAttribute{
+				Resource: &schemaR.SetAttribute{
+					MarkdownDescription: "A set of Destination Firewall Group IDs (`IP Sets` or `Security Groups`). Leaving it empty means `Any` (all).",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+			},
+			"app_port_profile_ids": superschema.SetAttribute{
+				Resource: &schemaR.SetAttribute{
+					MarkdownDescription: "A set of Application Port Profile IDs. Leaving it empty means `Any` (all).",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+			},
+			"before_rule_id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the rule before which this rule is placed. The position is applied when the rule is created or when this attribute is changed. If neither `before_rule_id` nor `after_rule_id` is set, the rule is added at the end of the list. There is no absolute position (priority) attribute because the index of a rule shifts each time a rule managed by another configuration is added or removed.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRoot("after_rule_id")),
+					},
+				},
+			},
+			"after_rule_id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the rule after which this rule is placed. The position is applied when the rule is created or when this attribute is changed.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRoot("before_rule_id")),
+					},
+				},
+			},
+		},
+	}
+}
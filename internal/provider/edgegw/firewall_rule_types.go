@@ -0,0 +1,36 @@
+package edgegw
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type firewallRuleModel struct {
+	ID                types.String `tfsdk:"id"`
+	EdgeGatewayID     types.String `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName   types.String `tfsdk:"edge_gateway_name"`
+	Name              types.String `tfsdk:"name"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	Direction         types.String `tfsdk:"direction"`
+	IPProtocol        types.String `tfsdk:"ip_protocol"`
+	Action            types.String `tfsdk:"action"`
+	Logging           types.Bool   `tfsdk:"logging"`
+	SourceIDs         types.Set    `tfsdk:"source_ids"`
+	DestinationIDs    types.Set    `tfsdk:"destination_ids"`
+	AppPortProfileIDs types.Set    `tfsdk:"app_port_profile_ids"`
+	BeforeRuleID      types.String `tfsdk:"before_rule_id"`
+	AfterRuleID       types.String `tfsdk:"after_rule_id"`
+}
+
+// toFirewallModelRule returns the rule attributes of the model.
+func (rm *firewallRuleModel) toFirewallModelRule() firewallModelRule {
+	return firewallModelRule{
+		ID:                rm.ID,
+		Name:              rm.Name,
+		Enabled:           rm.Enabled,
+		Direction:         rm.Direction,
+		IPProtocol:        rm.IPProtocol,
+		Action:            rm.Action,
+		Logging:           rm.Logging,
+		SourceIDs:         rm.SourceIDs,
+		DestinationIDs:    rm.DestinationIDs,
+		AppPortProfileIDs: rm.AppPortProfileIDs,
+	}
+}
@@ -24,7 +24,7 @@ import (
 func firewallSchema(_ context.Context) superschema.Schema {
 	return superschema.Schema{
 		Resource: superschema.SchemaDetails{
-			MarkdownDescription: "The firewall resource allows you to manage rules on an Firewall.",
+			MarkdownDescription: "The firewall resource allows you to manage rules on an Firewall. The whole list of rules is managed by this resource, use the `cloudavenue_edgegateway_firewall_rule` resource to manage the rules individually.",
 		},
 		DataSource: superschema.SchemaDetails{
 			MarkdownDescription: "The firewall data source allows you to retrieve information about an Firewall.",
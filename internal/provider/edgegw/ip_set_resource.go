@@ -121,7 +121,10 @@ func (r *ipSetResource) Create(ctx context.Context, req resource.CreateRequest,
 	var createdIPSet *govcd.NsxtFirewallGroup
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 		ipSetConfig, d := plan.ToNsxtFirewallGroup(ctx, vdcOrVDCGroup.GetID())
 		resp.Diagnostics.Append(d...)
@@ -130,7 +133,10 @@ func (r *ipSetResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		createdIPSet, err = vdcOrVDCGroup.SetIPSet(ipSetConfig)
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 		ipSetConfig, d := plan.ToNsxtFirewallGroup(ctx, r.edgegw.GetID())
 		resp.Diagnostics.Append(d...)
@@ -226,7 +232,10 @@ func (r *ipSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	)
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 		ipSetConfig, d = plan.ToNsxtFirewallGroup(ctx, vdcOrVDCGroup.GetID())
 		resp.Diagnostics.Append(d...)
@@ -235,7 +244,10 @@ func (r *ipSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		ipSet, err = vdcOrVDCGroup.GetIPSetByID(state.ID.Get())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 		ipSetConfig, d = plan.ToNsxtFirewallGroup(ctx, r.edgegw.GetID())
 		resp.Diagnostics.Append(d...)
@@ -293,11 +305,17 @@ func (r *ipSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	var ipSet *govcd.NsxtFirewallGroup
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 		ipSet, err = vdcOrVDCGroup.GetIPSetByID(state.ID.Get())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 		ipSet, err = r.edgegw.GetIPSetByID(state.ID.Get())
 	}
@@ -0,0 +1,368 @@
+// Package edgegw provides a Terraform resource.
+package edgegw
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &natRuleResource{}
+	_ resource.ResourceWithConfigure   = &natRuleResource{}
+	_ resource.ResourceWithImportState = &natRuleResource{}
+)
+
+// NewNATRuleResource is a helper function to simplify the provider implementation.
+func NewNATRuleResource() resource.Resource {
+	return &natRuleResource{}
+}
+
+// natRuleResource is the resource implementation.
+type natRuleResource struct {
+	client *client.CloudAvenue
+	org    org.Org
+	edgegw edgegw.EdgeGateway
+}
+
+// Init Initializes the resource.
+func (r *natRuleResource) Init(ctx context.Context, rm *NATRuleModel) (diags diag.Diagnostics) {
+	var err error
+
+	r.org, diags = org.Init(r.client)
+	if diags.HasError() {
+		return
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(rm.EdgeGatewayID.Get()),
+		Name: types.StringValue(rm.EdgeGatewayName.Get()),
+	})
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway", err.Error())
+		return
+	}
+
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *natRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_nat_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *natRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = natRuleSchema(ctx).GetResource(ctx)
+}
+
+func (r *natRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *natRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &NATRuleModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource creation logic here.
+	*/
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	createdNatRule, err := r.edgegw.CreateNatRule(plan.ToNsxtNatRule())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating NAT rule", err.Error())
+		return
+	}
+
+	plan.ID.Set(createdNatRule.NsxtNatRule.ID)
+	state, _, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *natRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &NATRuleModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource read here
+	*/
+
+	stateRefreshed, found, d := r.read(ctx, state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *natRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		plan  = &NATRuleModel{}
+		state = &NATRuleModel{}
+	)
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource update here
+	*/
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	natRule, err := r.edgegw.GetNatRuleById(state.ID.Get())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving NAT rule", err.Error())
+		return
+	}
+
+	natRuleConfig := plan.ToNsxtNatRule()
+	natRuleConfig.ID = natRule.NsxtNatRule.ID
+	natRuleConfig.Version = natRule.NsxtNatRule.Version
+
+	if _, err := natRule.Update(natRuleConfig); err != nil {
+		resp.Diagnostics.AddError("Error updating NAT rule", err.Error())
+		return
+	}
+
+	stateRefreshed, _, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *natRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &NATRuleModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource deletion here
+	*/
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	natRule, err := r.edgegw.GetNatRuleById(state.ID.Get())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving NAT rule", err.Error())
+		return
+	}
+
+	if err := natRule.Delete(); err != nil {
+		resp.Diagnostics.AddError("Error deleting NAT rule", err.Error())
+		return
+	}
+}
+
+func (r *natRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		edgegwID, edgegwName string
+		d                    diag.Diagnostics
+		err                  error
+		natRule              *govcd.NsxtNatRule
+	)
+
+	// Split req.ID with dot. ID format is EdgeGatewayIDOrName.NATRuleNameOrID
+	idParts := strings.Split(req.ID, ".")
+
+	if len(idParts) != 2 {
+		resp.Diagnostics.AddError("Invalid ID format", "ID format is EdgeGatewayIDOrName.NATRuleNameOrID")
+		return
+	}
+
+	r.org, d = org.Init(r.client)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	if uuid.IsEdgeGateway(idParts[0]) {
+		edgegwID = idParts[0]
+	} else {
+		edgegwName = idParts[0]
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(edgegwID),
+		Name: types.StringValue(edgegwName),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import NAT rule.", err.Error())
+		return
+	}
+
+	// NAT rule ID is not a URN
+	if uuid.IsUUIDV4(idParts[1]) {
+		natRule, err = r.edgegw.GetNatRuleById(idParts[1])
+	} else {
+		natRule, err = r.edgegw.GetNatRuleByName(idParts[1])
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get NAT rule.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), natRule.NsxtNatRule.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), natRule.NsxtNatRule.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_id"), r.edgegw.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), r.edgegw.GetName())...)
+}
+
+// * CustomFuncs
+
+func (r *natRuleResource) read(_ context.Context, planOrState *NATRuleModel) (stateRefreshed *NATRuleModel, found bool, diags diag.Diagnostics) {
+	stateRefreshed = planOrState.Copy()
+
+	var (
+		natRule *govcd.NsxtNatRule
+		err     error
+	)
+
+	if planOrState.ID.IsKnown() {
+		natRule, err = r.edgegw.GetNatRuleById(planOrState.ID.Get())
+	} else {
+		natRule, err = r.edgegw.GetNatRuleByName(planOrState.Name.Get())
+	}
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving NAT rule", err.Error())
+		return nil, true, diags
+	}
+
+	stateRefreshed.ID.Set(natRule.NsxtNatRule.ID)
+	stateRefreshed.Name.Set(natRule.NsxtNatRule.Name)
+	stateRefreshed.Description = utils.SuperStringValueOrNull(natRule.NsxtNatRule.Description)
+	stateRefreshed.Enabled.Set(natRule.NsxtNatRule.Enabled)
+	stateRefreshed.RuleType.Set(natRule.NsxtNatRule.Type)
+	stateRefreshed.ExternalAddress = utils.SuperStringValueOrNull(natRule.NsxtNatRule.ExternalAddresses)
+	stateRefreshed.InternalAddress = utils.SuperStringValueOrNull(natRule.NsxtNatRule.InternalAddresses)
+	stateRefreshed.DnatExternalPort = utils.SuperStringValueOrNull(natRule.NsxtNatRule.DnatExternalPort)
+	stateRefreshed.SnatDestinationAddress = utils.SuperStringValueOrNull(natRule.NsxtNatRule.SnatDestinationAddresses)
+	stateRefreshed.FirewallMatch.Set(natRule.NsxtNatRule.FirewallMatch)
+	stateRefreshed.EdgeGatewayID.Set(r.edgegw.GetID())
+	stateRefreshed.EdgeGatewayName.Set(r.edgegw.GetName())
+
+	if natRule.NsxtNatRule.Priority != nil {
+		stateRefreshed.Priority.Set(int64(*natRule.NsxtNatRule.Priority))
+	} else {
+		stateRefreshed.Priority.Set(0)
+	}
+
+	if natRule.NsxtNatRule.ApplicationPortProfile != nil {
+		stateRefreshed.AppPortProfileID = utils.SuperStringValueOrNull(natRule.NsxtNatRule.ApplicationPortProfile.ID)
+	} else {
+		stateRefreshed.AppPortProfileID.SetNull()
+	}
+
+	return stateRefreshed, true, nil
+}
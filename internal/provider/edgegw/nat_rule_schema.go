@@ -0,0 +1,247 @@
+package edgegw
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func natRuleSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_edgegateway_nat_rule` resource allows you to create and manage NAT rules (SNAT, DNAT, NO_SNAT, NO_DNAT and REFLEXIVE) on an Edge Gateway.",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_edgegateway_nat_rule` data source allows you to retrieve information about a NAT rule on an Edge Gateway.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The ID of the NAT Rule.",
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"edge_gateway_name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"edge_gateway_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the NAT Rule.",
+					Required:            true,
+				},
+			},
+			"description": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The description of the NAT Rule.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"enabled": superschema.SuperBoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Enable or disable the NAT Rule.",
+					Computed:            true,
+				},
+				Resource: &schemaR.BoolAttribute{
+					Optional: true,
+					Default:  booldefault.StaticBool(true),
+				},
+			},
+			"rule_type": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The type of the NAT Rule.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						fstringvalidator.OneOfWithDescription(
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "SNAT",
+								Description: "Translates the internal IP address of packets sent from the organization to an external IP address. Used for outbound traffic.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "DNAT",
+								Description: "Translates the external IP address of packets received by the organization to an internal IP address. Used for inbound traffic.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "NO_SNAT",
+								Description: "Prevents the translation of the internal IP address of packets sent from the organization.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "NO_DNAT",
+								Description: "Prevents the translation of the external IP address of packets received by the organization.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "REFLEXIVE",
+								Description: "Also known as stateless NAT. Translates the addresses in both directions without keeping track of the connection.",
+							},
+						),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"external_address": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The external IP address for the NAT Rule. It must be a public IP address of the Edge Gateway.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fstringvalidator.RequireIfAttributeIsOneOf(path.MatchRoot("rule_type"), []attr.Value{types.StringValue("SNAT"), types.StringValue("DNAT"), types.StringValue("NO_DNAT"), types.StringValue("REFLEXIVE")}),
+						fstringvalidator.NullIfAttributeIsOneOf(path.MatchRoot("rule_type"), []attr.Value{types.StringValue("NO_SNAT")}),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"internal_address": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The internal IP address or a range of IP addresses (CIDR) of the virtual machines for the NAT Rule.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fstringvalidator.RequireIfAttributeIsOneOf(path.MatchRoot("rule_type"), []attr.Value{types.StringValue("SNAT"), types.StringValue("DNAT"), types.StringValue("NO_SNAT"), types.StringValue("REFLEXIVE")}),
+						fstringvalidator.NullIfAttributeIsOneOf(path.MatchRoot("rule_type"), []attr.Value{types.StringValue("NO_DNAT")}),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"app_port_profile_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the application port profile to which the NAT Rule applies. If not set, the NAT Rule applies to any traffic.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"dnat_external_port": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The port or a range of ports into which the DNAT rule is translating the packets inbound to the virtual machines. (e.g. `8080` or `8000-8100`)",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fstringvalidator.NullIfAttributeIsOneOf(path.MatchRoot("rule_type"), []attr.Value{types.StringValue("SNAT"), types.StringValue("NO_SNAT"), types.StringValue("REFLEXIVE")}),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"snat_destination_address": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The destination IP address or a range of IP addresses (CIDR) for which the SNAT rule applies. If not set, the SNAT rule applies to all destinations outside of the local subnet.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fstringvalidator.NullIfAttributeIsOneOf(path.MatchRoot("rule_type"), []attr.Value{types.StringValue("DNAT"), types.StringValue("NO_DNAT"), types.StringValue("REFLEXIVE")}),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"firewall_match": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "Determines how the firewall matches the address during NATing if the firewall stage is not skipped.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Default:  stringdefault.StaticString("MATCH_INTERNAL_ADDRESS"),
+					Validators: []validator.String{
+						fstringvalidator.OneOfWithDescription(
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "MATCH_INTERNAL_ADDRESS",
+								Description: "The firewall will be applied to the internal address of the NAT Rule.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "MATCH_EXTERNAL_ADDRESS",
+								Description: "The firewall will be applied to the external address of the NAT Rule.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       "BYPASS",
+								Description: "The firewall stage will be skipped.",
+							},
+						),
+					},
+				},
+			},
+			"priority": superschema.SuperInt64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The priority of the NAT Rule. If an address has multiple NAT rules, the rule with the highest priority is applied. A lower value means a higher priority.",
+					Computed:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					Optional: true,
+					Default:  int64default.StaticInt64(0),
+					Validators: []validator.Int64{
+						int64validator.Between(0, 2147481599),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package edgegw
+
+import (
+	"fmt"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+type NATRuleModel struct {
+	AppPortProfileID       supertypes.StringValue `tfsdk:"app_port_profile_id"`
+	Description            supertypes.StringValue `tfsdk:"description"`
+	DnatExternalPort       supertypes.StringValue `tfsdk:"dnat_external_port"`
+	EdgeGatewayID          supertypes.StringValue `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName        supertypes.StringValue `tfsdk:"edge_gateway_name"`
+	Enabled                supertypes.BoolValue   `tfsdk:"enabled"`
+	ExternalAddress        supertypes.StringValue `tfsdk:"external_address"`
+	FirewallMatch          supertypes.StringValue `tfsdk:"firewall_match"`
+	ID                     supertypes.StringValue `tfsdk:"id"`
+	InternalAddress        supertypes.StringValue `tfsdk:"internal_address"`
+	Name                   supertypes.StringValue `tfsdk:"name"`
+	Priority               supertypes.Int64Value  `tfsdk:"priority"`
+	RuleType               supertypes.StringValue `tfsdk:"rule_type"`
+	SnatDestinationAddress supertypes.StringValue `tfsdk:"snat_destination_address"`
+}
+
+func NewNATRule(t any) *NATRuleModel {
+	switch t.(type) {
+	case tfsdk.State, tfsdk.Plan, tfsdk.Config:
+		return &NATRuleModel{
+			AppPortProfileID:       supertypes.NewStringNull(),
+			Description:            supertypes.NewStringNull(),
+			DnatExternalPort:       supertypes.NewStringNull(),
+			EdgeGatewayID:          supertypes.NewStringUnknown(),
+			EdgeGatewayName:        supertypes.NewStringUnknown(),
+			Enabled:                supertypes.NewBoolUnknown(),
+			ExternalAddress:        supertypes.NewStringNull(),
+			FirewallMatch:          supertypes.NewStringUnknown(),
+			ID:                     supertypes.NewStringUnknown(),
+			InternalAddress:        supertypes.NewStringNull(),
+			Name:                   supertypes.NewStringNull(),
+			Priority:               supertypes.NewInt64Unknown(),
+			RuleType:               supertypes.NewStringNull(),
+			SnatDestinationAddress: supertypes.NewStringNull(),
+		}
+
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+func (rm *NATRuleModel) Copy() *NATRuleModel {
+	x := &NATRuleModel{}
+	utils.ModelCopy(rm, x)
+	return x
+}
+
+// * CustomFuncs
+
+// ToNsxtNatRule converts the model to a govcdtypes.NsxtNatRule.
+func (rm *NATRuleModel) ToNsxtNatRule() *govcdtypes.NsxtNatRule {
+	natRuleConfig := &govcdtypes.NsxtNatRule{
+		Name:                     rm.Name.Get(),
+		Description:              rm.Description.Get(),
+		Enabled:                  rm.Enabled.Get(),
+		Type:                     rm.RuleType.Get(),
+		ExternalAddresses:        rm.ExternalAddress.Get(),
+		InternalAddresses:        rm.InternalAddress.Get(),
+		DnatExternalPort:         rm.DnatExternalPort.Get(),
+		SnatDestinationAddresses: rm.SnatDestinationAddress.Get(),
+		FirewallMatch:            rm.FirewallMatch.Get(),
+		Priority:                 utils.TakeIntPointer(int(rm.Priority.Get())),
+	}
+
+	if rm.AppPortProfileID.Get() != "" {
+		natRuleConfig.ApplicationPortProfile = &govcdtypes.OpenApiReference{ID: rm.AppPortProfileID.Get()}
+	}
+
+	return natRuleConfig
+}
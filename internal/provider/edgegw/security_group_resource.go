@@ -116,7 +116,10 @@ func (r *securityGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
 	securityGroup, d := r.securityGroupToNsxtFirewallGroup(ctx, plan)
@@ -211,7 +214,10 @@ func (r *securityGroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
 	securityGroup, d := r.securityGroupToNsxtFirewallGroup(ctx, plan)
@@ -274,7 +280,10 @@ func (r *securityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
 	// Get current Security Group
@@ -116,10 +116,16 @@ func (r *staticRouteResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -212,10 +218,16 @@ func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -276,10 +288,16 @@ func (r *staticRouteResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 	} else {
-		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		if errLock := mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID()); errLock != nil {
+			resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+			return
+		}
 		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
 	}
 
@@ -19,6 +19,8 @@ import (
 
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
 	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
 )
 
 func staticRouteSchema(_ context.Context) superschema.Schema {
@@ -88,7 +90,7 @@ func staticRouteSchema(_ context.Context) superschema.Schema {
 			},
 			"network_cidr": superschema.SuperStringAttribute{
 				Common: &schemaR.StringAttribute{
-					MarkdownDescription: "The network CIDR of the Static Route. (e.g. 192.168.1.0/24)",
+					MarkdownDescription: "The network CIDR of the Static Route. IPv4 and IPv6 networks are supported. (e.g. 192.168.1.0/24 or 2001:db8::/64)",
 				},
 				Resource: &schemaR.StringAttribute{
 					Required: true,
@@ -119,6 +121,7 @@ func staticRouteSchema(_ context.Context) superschema.Schema {
 							Required: true,
 							Validators: []validator.String{
 								fstringvalidator.IsIP(),
+								network.SameIPFamilyAs(path.MatchRoot("network_cidr")),
 							},
 						},
 						DataSource: &schemaD.StringAttribute{
@@ -0,0 +1,469 @@
+// Package edgegw provides a Terraform resource.
+package edgegw
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &vpnIPSecResource{}
+	_ resource.ResourceWithConfigure   = &vpnIPSecResource{}
+	_ resource.ResourceWithImportState = &vpnIPSecResource{}
+)
+
+// NewVPNIPSecResource is a helper function to simplify the provider implementation.
+func NewVPNIPSecResource() resource.Resource {
+	return &vpnIPSecResource{}
+}
+
+// vpnIPSecResource is the resource implementation.
+type vpnIPSecResource struct {
+	client *client.CloudAvenue
+	org    org.Org
+	edgegw edgegw.EdgeGateway
+}
+
+// Init Initializes the resource.
+func (r *vpnIPSecResource) Init(ctx context.Context, rm *VPNIPSecModel) (diags diag.Diagnostics) {
+	var err error
+
+	r.org, diags = org.Init(r.client)
+	if diags.HasError() {
+		return
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(rm.EdgeGatewayID.Get()),
+		Name: types.StringValue(rm.EdgeGatewayName.Get()),
+	})
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway", err.Error())
+		return
+	}
+
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *vpnIPSecResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_vpn_ipsec"
+}
+
+// Schema defines the schema for the resource.
+func (r *vpnIPSecResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = vpnIPSecSchema(ctx).GetResource(ctx)
+}
+
+func (r *vpnIPSecResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *vpnIPSecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &VPNIPSecModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource creation logic here.
+	*/
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	vpnConfig, d := plan.ToNsxtIPSecVPNTunnel(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdVPN, err := r.edgegw.CreateIpSecVpnTunnel(vpnConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating IPsec VPN Tunnel", err.Error())
+		return
+	}
+
+	plan.ID.Set(createdVPN.NsxtIpSecVpn.ID)
+
+	resp.Diagnostics.Append(r.setSecurityProfile(ctx, plan, createdVPN)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, _, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *vpnIPSecResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &VPNIPSecModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource read here
+	*/
+
+	stateRefreshed, found, d := r.read(ctx, state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *vpnIPSecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		plan  = &VPNIPSecModel{}
+		state = &VPNIPSecModel{}
+	)
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource update here
+	*/
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	vpn, err := r.edgegw.GetIpSecVpnTunnelById(state.ID.Get())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving IPsec VPN Tunnel", err.Error())
+		return
+	}
+
+	vpnConfig, d := plan.ToNsxtIPSecVPNTunnel(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpnConfig.ID = vpn.NsxtIpSecVpn.ID
+	vpnConfig.Version = vpn.NsxtIpSecVpn.Version
+	// Keep the custom security type if a security profile is still defined, it is updated below.
+	if plan.SecurityProfile.IsKnown() {
+		vpnConfig.SecurityType = vpn.NsxtIpSecVpn.SecurityType
+	}
+
+	updatedVPN, err := vpn.Update(vpnConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating IPsec VPN Tunnel", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.setSecurityProfile(ctx, plan, updatedVPN)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRefreshed, _, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *vpnIPSecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &VPNIPSecModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource deletion here
+	*/
+
+	if errLock := r.edgegw.Lock(ctx); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer r.edgegw.Unlock(ctx)
+
+	vpn, err := r.edgegw.GetIpSecVpnTunnelById(state.ID.Get())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving IPsec VPN Tunnel", err.Error())
+		return
+	}
+
+	if err := vpn.Delete(); err != nil {
+		resp.Diagnostics.AddError("Error deleting IPsec VPN Tunnel", err.Error())
+		return
+	}
+}
+
+func (r *vpnIPSecResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		edgegwID, edgegwName string
+		d                    diag.Diagnostics
+		err                  error
+		vpn                  *govcd.NsxtIpSecVpnTunnel
+	)
+
+	// Split req.ID with dot. ID format is EdgeGatewayIDOrName.VPNIPSecNameOrID
+	idParts := strings.Split(req.ID, ".")
+
+	if len(idParts) != 2 {
+		resp.Diagnostics.AddError("Invalid ID format", "ID format is EdgeGatewayIDOrName.VPNIPSecNameOrID")
+		return
+	}
+
+	r.org, d = org.Init(r.client)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	if uuid.IsEdgeGateway(idParts[0]) {
+		edgegwID = idParts[0]
+	} else {
+		edgegwName = idParts[0]
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(edgegwID),
+		Name: types.StringValue(edgegwName),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import IPsec VPN Tunnel.", err.Error())
+		return
+	}
+
+	// IPsec VPN Tunnel ID is not a URN
+	if uuid.IsUUIDV4(idParts[1]) {
+		vpn, err = r.edgegw.GetIpSecVpnTunnelById(idParts[1])
+	} else {
+		vpn, err = r.edgegw.GetIpSecVpnTunnelByName(idParts[1])
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get IPsec VPN Tunnel.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vpn.NsxtIpSecVpn.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), vpn.NsxtIpSecVpn.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_id"), r.edgegw.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), r.edgegw.GetName())...)
+}
+
+// * CustomFuncs
+
+// setSecurityProfile applies the custom security profile of the plan to the IPsec VPN Tunnel.
+func (r *vpnIPSecResource) setSecurityProfile(ctx context.Context, plan *VPNIPSecModel, vpn *govcd.NsxtIpSecVpnTunnel) (diags diag.Diagnostics) {
+	if !plan.SecurityProfile.IsKnown() {
+		return
+	}
+
+	securityProfile, d := plan.ToNsxtIPSecVPNTunnelSecurityProfile(ctx)
+	if d.HasError() {
+		return d
+	}
+
+	if _, err := vpn.UpdateTunnelConnectionProperties(securityProfile); err != nil {
+		diags.AddError("Error updating IPsec VPN Tunnel security profile", err.Error())
+	}
+
+	return
+}
+
+func (r *vpnIPSecResource) read(ctx context.Context, planOrState *VPNIPSecModel) (stateRefreshed *VPNIPSecModel, found bool, diags diag.Diagnostics) {
+	stateRefreshed = planOrState.Copy()
+
+	var (
+		vpn *govcd.NsxtIpSecVpnTunnel
+		err error
+	)
+
+	if planOrState.ID.IsKnown() {
+		vpn, err = r.edgegw.GetIpSecVpnTunnelById(planOrState.ID.Get())
+	} else {
+		vpn, err = r.edgegw.GetIpSecVpnTunnelByName(planOrState.Name.Get())
+	}
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving IPsec VPN Tunnel", err.Error())
+		return nil, true, diags
+	}
+
+	stateRefreshed.ID.Set(vpn.NsxtIpSecVpn.ID)
+	stateRefreshed.Name.Set(vpn.NsxtIpSecVpn.Name)
+	stateRefreshed.Description = utils.SuperStringValueOrNull(vpn.NsxtIpSecVpn.Description)
+	stateRefreshed.Enabled.Set(vpn.NsxtIpSecVpn.Enabled)
+	stateRefreshed.LocalIPAddress.Set(vpn.NsxtIpSecVpn.LocalEndpoint.LocalAddress)
+	stateRefreshed.RemoteIPAddress.Set(vpn.NsxtIpSecVpn.RemoteEndpoint.RemoteAddress)
+	stateRefreshed.EdgeGatewayID.Set(r.edgegw.GetID())
+	stateRefreshed.EdgeGatewayName.Set(r.edgegw.GetName())
+
+	// The pre-shared key is not always returned by the API.
+	if vpn.NsxtIpSecVpn.PreSharedKey != "" {
+		stateRefreshed.PreSharedKey.Set(vpn.NsxtIpSecVpn.PreSharedKey)
+	}
+
+	stateRefreshed.CertificateID.SetNull()
+	stateRefreshed.CACertificateID.SetNull()
+	if vpn.NsxtIpSecVpn.CertificateRef != nil {
+		stateRefreshed.CertificateID.Set(vpn.NsxtIpSecVpn.CertificateRef.ID)
+	}
+	if vpn.NsxtIpSecVpn.CaCertificateRef != nil {
+		stateRefreshed.CACertificateID.Set(vpn.NsxtIpSecVpn.CaCertificateRef.ID)
+	}
+
+	diags.Append(stateRefreshed.LocalNetworks.Set(ctx, vpn.NsxtIpSecVpn.LocalEndpoint.LocalNetworks)...)
+	diags.Append(stateRefreshed.RemoteNetworks.Set(ctx, vpn.NsxtIpSecVpn.RemoteEndpoint.RemoteNetworks)...)
+	if diags.HasError() {
+		return nil, true, diags
+	}
+
+	if vpn.NsxtIpSecVpn.SecurityType == vpnIPSecSecurityTypeCustom {
+		securityProfile, err := vpn.GetTunnelConnectionProperties()
+		if err != nil {
+			diags.AddError("Error retrieving IPsec VPN Tunnel security profile", err.Error())
+			return nil, true, diags
+		}
+
+		x := VPNIPSecModelSecurityProfile{}
+		x.IkeVersion.Set(securityProfile.IkeConfiguration.IkeVersion)
+		x.IkeEncryptionAlgorithm.Set(firstOrEmpty(securityProfile.IkeConfiguration.EncryptionAlgorithms))
+		x.IkeDigestAlgorithm.Set(firstOrEmpty(securityProfile.IkeConfiguration.DigestAlgorithms))
+		x.IkeDhGroup.Set(firstOrEmpty(securityProfile.IkeConfiguration.DhGroups))
+		x.TunnelPfsEnabled.Set(securityProfile.TunnelConfiguration.PerfectForwardSecrecyEnabled)
+		x.TunnelDfPolicy.Set(securityProfile.TunnelConfiguration.DfPolicy)
+		x.TunnelEncryptionAlgorithm.Set(firstOrEmpty(securityProfile.TunnelConfiguration.EncryptionAlgorithms))
+		x.TunnelDigestAlgorithm = utils.SuperStringValueOrNull(firstOrEmpty(securityProfile.TunnelConfiguration.DigestAlgorithms))
+		x.TunnelDhGroup.Set(firstOrEmpty(securityProfile.TunnelConfiguration.DhGroups))
+		x.DpdProbeInterval.Set(int64(securityProfile.DpdConfiguration.ProbeInterval))
+
+		if securityProfile.IkeConfiguration.SaLifeTime != nil {
+			x.IkeSaLifetime.Set(int64(*securityProfile.IkeConfiguration.SaLifeTime))
+		}
+		if securityProfile.TunnelConfiguration.SaLifeTime != nil {
+			x.TunnelSaLifetime.Set(int64(*securityProfile.TunnelConfiguration.SaLifeTime))
+		}
+
+		diags.Append(stateRefreshed.SecurityProfile.Set(ctx, x)...)
+		if diags.HasError() {
+			return nil, true, diags
+		}
+	} else {
+		stateRefreshed.SecurityProfile.SetNull(ctx)
+	}
+
+	// The status is not immediately available after the creation of the tunnel.
+	stateRefreshed.Status.SetNull()
+	stateRefreshed.IkeServiceStatus.SetNull()
+	stateRefreshed.IkeFailReason.SetNull()
+	if status, err := vpn.GetStatus(); err == nil {
+		stateRefreshed.Status = utils.SuperStringValueOrNull(status.TunnelStatus)
+		stateRefreshed.IkeServiceStatus = utils.SuperStringValueOrNull(status.IkeStatus.IkeServiceStatus)
+		stateRefreshed.IkeFailReason = utils.SuperStringValueOrNull(status.IkeStatus.FailReason)
+	}
+
+	return stateRefreshed, true, diags
+}
+
+// firstOrEmpty returns the first element of the slice or an empty string.
+func firstOrEmpty(s []string) string {
+	if len(s) == 0 {
+		return ""
+	}
+	return s[0]
+}
@@ -0,0 +1,406 @@
+package edgegw
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
+)
+
+var (
+	vpnIPSecEncryptionAlgorithms = []string{"AES_128", "AES_256", "AES_GCM_128", "AES_GCM_192", "AES_GCM_256"}
+	vpnIPSecDigestAlgorithms     = []string{"SHA1", "SHA2_256", "SHA2_384", "SHA2_512"}
+	vpnIPSecDHGroups             = []string{"GROUP2", "GROUP5", "GROUP14", "GROUP15", "GROUP16", "GROUP19", "GROUP20", "GROUP21"}
+)
+
+func vpnIPSecSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_edgegateway_vpn_ipsec` resource allows you to create and manage site-to-site IPsec VPN tunnels on an Edge Gateway.",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_edgegateway_vpn_ipsec` data source allows you to retrieve information about an IPsec VPN tunnel on an Edge Gateway, including the status of the tunnel.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The ID of the IPsec VPN Tunnel.",
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"edge_gateway_name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"edge_gateway_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the IPsec VPN Tunnel.",
+					Required:            true,
+				},
+			},
+			"description": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The description of the IPsec VPN Tunnel.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"enabled": superschema.SuperBoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Enable or disable the IPsec VPN Tunnel.",
+					Computed:            true,
+				},
+				Resource: &schemaR.BoolAttribute{
+					Optional: true,
+					Default:  booldefault.StaticBool(true),
+				},
+			},
+			"pre_shared_key": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The pre-shared key used for authentication. It must be the same on the remote endpoint of the IPsec VPN Tunnel.",
+					Sensitive:           true,
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("pre_shared_key"), path.MatchRoot("certificate_id")),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"certificate_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the certificate used for authentication. The certificate can be managed with the `cloudavenue_certificate` resource.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("pre_shared_key"), path.MatchRoot("certificate_id")),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"ca_certificate_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the CA certificate used to verify the certificate of the remote endpoint.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRoot("certificate_id")),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"local_ip_address": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The IPv4 address of the local endpoint. It must be a public IP address of the Edge Gateway.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						fstringvalidator.IsIP(),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"local_networks": superschema.SuperSetAttribute{
+				Common: &schemaR.SetAttribute{
+					MarkdownDescription: "The local networks in CIDR format (e.g. 192.168.1.0/24).",
+					ElementType:         supertypes.StringType{},
+				},
+				Resource: &schemaR.SetAttribute{
+					Required: true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+				DataSource: &schemaD.SetAttribute{
+					Computed: true,
+				},
+			},
+			"remote_ip_address": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The IPv4 address of the remote endpoint terminating the IPsec VPN Tunnel.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						fstringvalidator.IsIP(),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"remote_networks": superschema.SuperSetAttribute{
+				Common: &schemaR.SetAttribute{
+					MarkdownDescription: "The remote networks in CIDR format (e.g. 192.168.2.0/24).",
+					ElementType:         supertypes.StringType{},
+					Computed:            true,
+				},
+				Resource: &schemaR.SetAttribute{
+					MarkdownDescription: "If not set, `0.0.0.0/0` is used.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.Set{
+						setplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"security_profile": superschema.SuperSingleNestedAttribute{
+				Common: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The custom security profile of the IPsec VPN Tunnel (IKE, tunnel and Dead Peer Detection settings).",
+				},
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "If not set, the default security profile of the platform is used.",
+					Optional:            true,
+				},
+				DataSource: &schemaD.SingleNestedAttribute{
+					Computed: true,
+				},
+				Attributes: superschema.Attributes{
+					"ike_version": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The IKE protocol version.",
+							Computed:            true,
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+							Default:  stringdefault.StaticString("IKE_V2"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("IKE_V1", "IKE_V2", "IKE_FLEX"),
+							},
+						},
+					},
+					"ike_encryption_algorithm": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The encryption algorithm used during the IKE negotiation.",
+							Computed:            true,
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+							Default:  stringdefault.StaticString("AES_128"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(vpnIPSecEncryptionAlgorithms...),
+							},
+						},
+					},
+					"ike_digest_algorithm": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The secure hashing algorithm used during the IKE negotiation.",
+							Computed:            true,
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+							Default:  stringdefault.StaticString("SHA2_256"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(vpnIPSecDigestAlgorithms...),
+							},
+						},
+					},
+					"ike_dh_group": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The Diffie-Hellman group used during the IKE negotiation.",
+							Computed:            true,
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+							Default:  stringdefault.StaticString("GROUP14"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(vpnIPSecDHGroups...),
+							},
+						},
+					},
+					"ike_sa_lifetime": superschema.SuperInt64Attribute{
+						Common: &schemaR.Int64Attribute{
+							MarkdownDescription: "The IKE security association lifetime in seconds.",
+							Computed:            true,
+						},
+						Resource: &schemaR.Int64Attribute{
+							Optional: true,
+							Default:  int64default.StaticInt64(86400),
+							Validators: []validator.Int64{
+								int64validator.Between(21600, 31536000),
+							},
+						},
+					},
+					"tunnel_pfs_enabled": superschema.SuperBoolAttribute{
+						Common: &schemaR.BoolAttribute{
+							MarkdownDescription: "Enable or disable the Perfect Forward Secrecy (PFS).",
+							Computed:            true,
+						},
+						Resource: &schemaR.BoolAttribute{
+							Optional: true,
+							Default:  booldefault.StaticBool(true),
+						},
+					},
+					"tunnel_df_policy": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The policy for handling the defragmentation bit.",
+							Computed:            true,
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+							Default:  stringdefault.StaticString("COPY"),
+							Validators: []validator.String{
+								fstringvalidator.OneOfWithDescription(
+									fstringvalidator.OneOfWithDescriptionValues{
+										Value:       "COPY",
+										Description: "Copies the defragmentation bit from the inner IP packet to the outer packet.",
+									},
+									fstringvalidator.OneOfWithDescriptionValues{
+										Value:       "CLEAR",
+										Description: "Ignores the defragmentation bit present in the inner packet.",
+									},
+								),
+							},
+						},
+					},
+					"tunnel_encryption_algorithm": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The encryption algorithm used by the tunnel.",
+							Computed:            true,
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+							Default:  stringdefault.StaticString("AES_GCM_128"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(append(vpnIPSecEncryptionAlgorithms, "NO_ENCRYPTION_AUTH_AES_GMAC_128", "NO_ENCRYPTION_AUTH_AES_GMAC_192", "NO_ENCRYPTION_AUTH_AES_GMAC_256", "NO_ENCRYPTION")...),
+							},
+						},
+					},
+					"tunnel_digest_algorithm": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The secure hashing algorithm used by the tunnel. Must not be set with the `AES_GCM_*` and `NO_ENCRYPTION_AUTH_AES_GMAC_*` encryption algorithms.",
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(vpnIPSecDigestAlgorithms...),
+							},
+						},
+						DataSource: &schemaD.StringAttribute{
+							Computed: true,
+						},
+					},
+					"tunnel_dh_group": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The Diffie-Hellman group used by the tunnel when the Perfect Forward Secrecy is enabled.",
+							Computed:            true,
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+							Default:  stringdefault.StaticString("GROUP14"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(vpnIPSecDHGroups...),
+							},
+						},
+					},
+					"tunnel_sa_lifetime": superschema.SuperInt64Attribute{
+						Common: &schemaR.Int64Attribute{
+							MarkdownDescription: "The tunnel security association lifetime in seconds.",
+							Computed:            true,
+						},
+						Resource: &schemaR.Int64Attribute{
+							Optional: true,
+							Default:  int64default.StaticInt64(3600),
+							Validators: []validator.Int64{
+								int64validator.Between(900, 31536000),
+							},
+						},
+					},
+					"dpd_probe_interval": superschema.SuperInt64Attribute{
+						Common: &schemaR.Int64Attribute{
+							MarkdownDescription: "The interval in seconds between two Dead Peer Detection probes.",
+							Computed:            true,
+						},
+						Resource: &schemaR.Int64Attribute{
+							Optional: true,
+							Default:  int64default.StaticInt64(60),
+							Validators: []validator.Int64{
+								int64validator.Between(3, 60),
+							},
+						},
+					},
+				},
+			},
+			"status": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The overall status of the IPsec VPN Tunnel. The status may take some time to be available after the creation.",
+					Computed:            true,
+				},
+			},
+			"ike_service_status": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The status of the IKE session of the IPsec VPN Tunnel.",
+					Computed:            true,
+				},
+			},
+			"ike_fail_reason": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The reason of the failure if the IKE session is not up.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,243 @@
+package edgegw
+
+import (
+	"context"
+	"fmt"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+const (
+	vpnIPSecSecurityTypeDefault = "DEFAULT"
+	vpnIPSecSecurityTypeCustom  = "CUSTOM"
+
+	vpnIPSecAuthenticationModePSK         = "PSK"
+	vpnIPSecAuthenticationModeCertificate = "CERTIFICATE"
+)
+
+type VPNIPSecModel struct {
+	CACertificateID  supertypes.StringValue       `tfsdk:"ca_certificate_id"`
+	CertificateID    supertypes.StringValue       `tfsdk:"certificate_id"`
+	Description      supertypes.StringValue       `tfsdk:"description"`
+	EdgeGatewayID    supertypes.StringValue       `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName  supertypes.StringValue       `tfsdk:"edge_gateway_name"`
+	Enabled          supertypes.BoolValue         `tfsdk:"enabled"`
+	ID               supertypes.StringValue       `tfsdk:"id"`
+	IkeFailReason    supertypes.StringValue       `tfsdk:"ike_fail_reason"`
+	IkeServiceStatus supertypes.StringValue       `tfsdk:"ike_service_status"`
+	LocalIPAddress   supertypes.StringValue       `tfsdk:"local_ip_address"`
+	LocalNetworks    supertypes.SetValue          `tfsdk:"local_networks"`
+	Name             supertypes.StringValue       `tfsdk:"name"`
+	PreSharedKey     supertypes.StringValue       `tfsdk:"pre_shared_key"`
+	RemoteIPAddress  supertypes.StringValue       `tfsdk:"remote_ip_address"`
+	RemoteNetworks   supertypes.SetValue          `tfsdk:"remote_networks"`
+	SecurityProfile  supertypes.SingleNestedValue `tfsdk:"security_profile"`
+	Status           supertypes.StringValue       `tfsdk:"status"`
+}
+
+type VPNIPSecModelNetworks []supertypes.StringValue
+
+// * SecurityProfile.
+type VPNIPSecModelSecurityProfile struct {
+	DpdProbeInterval          supertypes.Int64Value  `tfsdk:"dpd_probe_interval"`
+	IkeDhGroup                supertypes.StringValue `tfsdk:"ike_dh_group"`
+	IkeDigestAlgorithm        supertypes.StringValue `tfsdk:"ike_digest_algorithm"`
+	IkeEncryptionAlgorithm    supertypes.StringValue `tfsdk:"ike_encryption_algorithm"`
+	IkeSaLifetime             supertypes.Int64Value  `tfsdk:"ike_sa_lifetime"`
+	IkeVersion                supertypes.StringValue `tfsdk:"ike_version"`
+	TunnelDfPolicy            supertypes.StringValue `tfsdk:"tunnel_df_policy"`
+	TunnelDhGroup             supertypes.StringValue `tfsdk:"tunnel_dh_group"`
+	TunnelDigestAlgorithm     supertypes.StringValue `tfsdk:"tunnel_digest_algorithm"`
+	TunnelEncryptionAlgorithm supertypes.StringValue `tfsdk:"tunnel_encryption_algorithm"`
+	TunnelPfsEnabled          supertypes.BoolValue   `tfsdk:"tunnel_pfs_enabled"`
+	TunnelSaLifetime          supertypes.Int64Value  `tfsdk:"tunnel_sa_lifetime"`
+}
+
+func NewVPNIPSec(t any) *VPNIPSecModel {
+	switch x := t.(type) {
+	case tfsdk.State:
+		return &VPNIPSecModel{
+			CACertificateID:  supertypes.NewStringNull(),
+			CertificateID:    supertypes.NewStringNull(),
+			Description:      supertypes.NewStringNull(),
+			EdgeGatewayID:    supertypes.NewStringUnknown(),
+			EdgeGatewayName:  supertypes.NewStringUnknown(),
+			Enabled:          supertypes.NewBoolUnknown(),
+			ID:               supertypes.NewStringUnknown(),
+			IkeFailReason:    supertypes.NewStringUnknown(),
+			IkeServiceStatus: supertypes.NewStringUnknown(),
+			LocalIPAddress:   supertypes.NewStringNull(),
+			LocalNetworks:    supertypes.NewSetNull(x.Schema.GetAttributes()["local_networks"].GetType().(supertypes.SetType).ElementType()),
+			Name:             supertypes.NewStringNull(),
+			PreSharedKey:     supertypes.NewStringNull(),
+			RemoteIPAddress:  supertypes.NewStringNull(),
+			RemoteNetworks:   supertypes.NewSetUnknown(x.Schema.GetAttributes()["remote_networks"].GetType().(supertypes.SetType).ElementType()),
+			SecurityProfile:  supertypes.NewSingleNestedNull(x.Schema.GetAttributes()["security_profile"].GetType().(supertypes.SingleNestedType).AttributeTypes()),
+			Status:           supertypes.NewStringUnknown(),
+		}
+
+	case tfsdk.Plan:
+		return &VPNIPSecModel{
+			CACertificateID:  supertypes.NewStringNull(),
+			CertificateID:    supertypes.NewStringNull(),
+			Description:      supertypes.NewStringNull(),
+			EdgeGatewayID:    supertypes.NewStringUnknown(),
+			EdgeGatewayName:  supertypes.NewStringUnknown(),
+			Enabled:          supertypes.NewBoolUnknown(),
+			ID:               supertypes.NewStringUnknown(),
+			IkeFailReason:    supertypes.NewStringUnknown(),
+			IkeServiceStatus: supertypes.NewStringUnknown(),
+			LocalIPAddress:   supertypes.NewStringNull(),
+			LocalNetworks:    supertypes.NewSetNull(x.Schema.GetAttributes()["local_networks"].GetType().(supertypes.SetType).ElementType()),
+			Name:             supertypes.NewStringNull(),
+			PreSharedKey:     supertypes.NewStringNull(),
+			RemoteIPAddress:  supertypes.NewStringNull(),
+			RemoteNetworks:   supertypes.NewSetUnknown(x.Schema.GetAttributes()["remote_networks"].GetType().(supertypes.SetType).ElementType()),
+			SecurityProfile:  supertypes.NewSingleNestedNull(x.Schema.GetAttributes()["security_profile"].GetType().(supertypes.SingleNestedType).AttributeTypes()),
+			Status:           supertypes.NewStringUnknown(),
+		}
+
+	case tfsdk.Config:
+		return &VPNIPSecModel{
+			CACertificateID:  supertypes.NewStringNull(),
+			CertificateID:    supertypes.NewStringNull(),
+			Description:      supertypes.NewStringNull(),
+			EdgeGatewayID:    supertypes.NewStringUnknown(),
+			EdgeGatewayName:  supertypes.NewStringUnknown(),
+			Enabled:          supertypes.NewBoolUnknown(),
+			ID:               supertypes.NewStringUnknown(),
+			IkeFailReason:    supertypes.NewStringUnknown(),
+			IkeServiceStatus: supertypes.NewStringUnknown(),
+			LocalIPAddress:   supertypes.NewStringNull(),
+			LocalNetworks:    supertypes.NewSetNull(x.Schema.GetAttributes()["local_networks"].GetType().(supertypes.SetType).ElementType()),
+			Name:             supertypes.NewStringNull(),
+			PreSharedKey:     supertypes.NewStringNull(),
+			RemoteIPAddress:  supertypes.NewStringNull(),
+			RemoteNetworks:   supertypes.NewSetUnknown(x.Schema.GetAttributes()["remote_networks"].GetType().(supertypes.SetType).ElementType()),
+			SecurityProfile:  supertypes.NewSingleNestedNull(x.Schema.GetAttributes()["security_profile"].GetType().(supertypes.SingleNestedType).AttributeTypes()),
+			Status:           supertypes.NewStringUnknown(),
+		}
+
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+func (rm *VPNIPSecModel) Copy() *VPNIPSecModel {
+	x := &VPNIPSecModel{}
+	utils.ModelCopy(rm, x)
+	return x
+}
+
+// GetLocalNetworks returns the value of the LocalNetworks field.
+func (rm *VPNIPSecModel) GetLocalNetworks(ctx context.Context) (values VPNIPSecModelNetworks, diags diag.Diagnostics) {
+	values = make(VPNIPSecModelNetworks, 0)
+	d := rm.LocalNetworks.Get(ctx, &values, false)
+	return values, d
+}
+
+// GetRemoteNetworks returns the value of the RemoteNetworks field.
+func (rm *VPNIPSecModel) GetRemoteNetworks(ctx context.Context) (values VPNIPSecModelNetworks, diags diag.Diagnostics) {
+	values = make(VPNIPSecModelNetworks, 0)
+	if !rm.RemoteNetworks.IsKnown() {
+		return values, nil
+	}
+	d := rm.RemoteNetworks.Get(ctx, &values, false)
+	return values, d
+}
+
+// GetSecurityProfile returns the value of the SecurityProfile field.
+func (rm *VPNIPSecModel) GetSecurityProfile(ctx context.Context) (values VPNIPSecModelSecurityProfile, diags diag.Diagnostics) {
+	values = VPNIPSecModelSecurityProfile{}
+	d := rm.SecurityProfile.Get(ctx, &values, basetypes.ObjectAsOptions{})
+	return values, d
+}
+
+func (r *VPNIPSecModelNetworks) Get() []string {
+	return utils.SuperSliceTypesStringToSliceString(*r)
+}
+
+// * CustomFuncs
+
+// ToNsxtIPSecVPNTunnel returns the NSX-T IPsec VPN Tunnel representation of the model.
+func (rm *VPNIPSecModel) ToNsxtIPSecVPNTunnel(ctx context.Context) (*govcdtypes.NsxtIpSecVpnTunnel, diag.Diagnostics) {
+	localNetworks, d := rm.GetLocalNetworks(ctx)
+	if d.HasError() {
+		return nil, d
+	}
+
+	remoteNetworks, d := rm.GetRemoteNetworks(ctx)
+	if d.HasError() {
+		return nil, d
+	}
+
+	tunnel := &govcdtypes.NsxtIpSecVpnTunnel{
+		Name:        rm.Name.Get(),
+		Description: rm.Description.Get(),
+		Enabled:     rm.Enabled.Get(),
+		LocalEndpoint: govcdtypes.NsxtIpSecVpnTunnelLocalEndpoint{
+			LocalAddress:  rm.LocalIPAddress.Get(),
+			LocalNetworks: localNetworks.Get(),
+		},
+		RemoteEndpoint: govcdtypes.NsxtIpSecVpnTunnelRemoteEndpoint{
+			RemoteAddress:  rm.RemoteIPAddress.Get(),
+			RemoteNetworks: remoteNetworks.Get(),
+		},
+		PreSharedKey:       rm.PreSharedKey.Get(),
+		SecurityType:       vpnIPSecSecurityTypeDefault,
+		AuthenticationMode: vpnIPSecAuthenticationModePSK,
+	}
+
+	if rm.CertificateID.IsKnown() {
+		tunnel.AuthenticationMode = vpnIPSecAuthenticationModeCertificate
+		tunnel.CertificateRef = &govcdtypes.OpenApiReference{ID: rm.CertificateID.Get()}
+		if rm.CACertificateID.IsKnown() {
+			tunnel.CaCertificateRef = &govcdtypes.OpenApiReference{ID: rm.CACertificateID.Get()}
+		}
+	}
+
+	return tunnel, nil
+}
+
+// ToNsxtIPSecVPNTunnelSecurityProfile returns the NSX-T IPsec VPN Tunnel security profile representation of the model.
+func (rm *VPNIPSecModel) ToNsxtIPSecVPNTunnelSecurityProfile(ctx context.Context) (*govcdtypes.NsxtIpSecVpnTunnelSecurityProfile, diag.Diagnostics) {
+	securityProfile, d := rm.GetSecurityProfile(ctx)
+	if d.HasError() {
+		return nil, d
+	}
+
+	profile := &govcdtypes.NsxtIpSecVpnTunnelSecurityProfile{
+		SecurityType: vpnIPSecSecurityTypeCustom,
+		IkeConfiguration: govcdtypes.NsxtIpSecVpnTunnelProfileIkeConfiguration{
+			IkeVersion:           securityProfile.IkeVersion.Get(),
+			EncryptionAlgorithms: []string{securityProfile.IkeEncryptionAlgorithm.Get()},
+			DigestAlgorithms:     []string{securityProfile.IkeDigestAlgorithm.Get()},
+			DhGroups:             []string{securityProfile.IkeDhGroup.Get()},
+			SaLifeTime:           utils.TakeIntPointer(int(securityProfile.IkeSaLifetime.Get())),
+		},
+		TunnelConfiguration: govcdtypes.NsxtIpSecVpnTunnelProfileTunnelConfiguration{
+			PerfectForwardSecrecyEnabled: securityProfile.TunnelPfsEnabled.Get(),
+			DfPolicy:                     securityProfile.TunnelDfPolicy.Get(),
+			EncryptionAlgorithms:         []string{securityProfile.TunnelEncryptionAlgorithm.Get()},
+			DhGroups:                     []string{securityProfile.TunnelDhGroup.Get()},
+			SaLifeTime:                   utils.TakeIntPointer(int(securityProfile.TunnelSaLifetime.Get())),
+		},
+		DpdConfiguration: govcdtypes.NsxtIpSecVpnTunnelProfileDpdConfiguration{
+			ProbeInterval: int(securityProfile.DpdProbeInterval.Get()),
+		},
+	}
+
+	if securityProfile.TunnelDigestAlgorithm.IsKnown() {
+		profile.TunnelConfiguration.DigestAlgorithms = []string{securityProfile.TunnelDigestAlgorithm.Get()}
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,59 @@
+package iam
+
+import (
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+)
+
+type group interface {
+	GetGroup(refresh bool) (*govcd.OrgGroup, error)
+}
+
+type commonGroup struct {
+	ID   types.String
+	Name types.String
+}
+
+// GetGroup.
+func (c *commonGroup) GetGroup(a adminorg.AdminOrg, refresh bool) (*govcd.OrgGroup, error) {
+	return a.GetGroupByNameOrId(c.GetIDOrName(), refresh)
+}
+
+// GetIDOrName.
+func (c *commonGroup) GetIDOrName() string {
+	if c.ID.ValueString() != "" {
+		return c.ID.ValueString()
+	}
+	return c.Name.ValueString()
+}
+
+// referencesToNames returns the names of the references.
+func referencesToNames(references []*govcdtypes.Reference) []string {
+	names := make([]string, 0, len(references))
+	for _, reference := range references {
+		if reference != nil {
+			names = append(names, reference.Name)
+		}
+	}
+	return names
+}
+
+// groupUserNames returns the names of the users belonging to the group.
+func groupUserNames(g *govcd.OrgGroup) []string {
+	if g.Group.UsersList == nil {
+		return []string{}
+	}
+	return referencesToNames(g.Group.UsersList.UserReference)
+}
+
+// userGroupNames returns the names of the groups the user belongs to.
+func userGroupNames(u *govcd.OrgUser) []string {
+	if u.User.GroupReferences == nil {
+		return []string{}
+	}
+	return referencesToNames(u.User.GroupReferences.GroupReference)
+}
@@ -0,0 +1,126 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &groupDataSource{}
+	_ datasource.DataSourceWithConfigure = &groupDataSource{}
+	_ group                              = &groupDataSource{}
+)
+
+// NewGroupDataSource returns a new Org Group data source.
+func NewGroupDataSource() datasource.DataSource {
+	return &groupDataSource{}
+}
+
+// groupDataSource implements the DataSource interface.
+type groupDataSource struct {
+	client   *client.CloudAvenue
+	adminOrg adminorg.AdminOrg
+	group    commonGroup
+}
+
+func (d *groupDataSource) Init(_ context.Context, rm *groupDataSourceModel) (diags diag.Diagnostics) {
+	d.group = commonGroup{
+		ID:   rm.ID,
+		Name: rm.Name,
+	}
+
+	d.adminOrg, diags = adminorg.Init(d.client)
+
+	return
+}
+
+// Metadata returns the resource type name.
+func (d *groupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_group"
+}
+
+// Schema defines the schema for the data source.
+func (d *groupDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = groupSchema().GetDataSource(ctx)
+}
+
+// Configure configures the data source.
+func (d *groupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read reads the data source.
+func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	config := &groupDataSourceModel{}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.Init(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the group by name or ID and return an error if it doesn't exist or there is another error
+	group, err := d.GetGroup(true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving group", err.Error())
+		return
+	}
+
+	// Populate the data source model with the group data
+	state := &groupDataSourceModel{
+		ID:           types.StringValue(group.Group.ID),
+		Name:         types.StringValue(group.Group.Name),
+		Description:  types.StringValue(group.Group.Description),
+		ProviderType: types.StringValue(group.Group.ProviderType),
+		RoleName:     types.StringNull(),
+	}
+
+	if group.Group.Role != nil {
+		state.RoleName = types.StringValue(group.Group.Role.Name)
+	}
+
+	var diags diag.Diagnostics
+	state.UserNames, diags = types.SetValueFrom(ctx, types.StringType, groupUserNames(group))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (d *groupDataSource) GetGroup(refresh bool) (*govcd.OrgGroup, error) {
+	return d.group.GetGroup(d.adminOrg, refresh)
+}
@@ -0,0 +1,256 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &groupResource{}
+	_ resource.ResourceWithConfigure   = &groupResource{}
+	_ resource.ResourceWithImportState = &groupResource{}
+	_ group                            = &groupResource{}
+)
+
+// NewGroupResource is a helper function to simplify the provider implementation.
+func NewGroupResource() resource.Resource {
+	return &groupResource{}
+}
+
+// groupResource is the resource implementation.
+type groupResource struct {
+	client   *client.CloudAvenue
+	adminOrg adminorg.AdminOrg
+	group    commonGroup
+}
+
+// Metadata returns the resource type name.
+func (r *groupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *groupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = groupSchema().GetResource(ctx)
+}
+
+func (r *groupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *groupResource) Init(_ context.Context, rm *groupResourceModel) (diags diag.Diagnostics) {
+	r.group = commonGroup{
+		ID:   rm.ID,
+		Name: rm.Name,
+	}
+
+	r.adminOrg, diags = adminorg.Init(r.client)
+
+	return
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &groupResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.adminOrg.GetRoleReference(plan.RoleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving role", err.Error())
+		return
+	}
+
+	group, err := r.adminOrg.CreateGroup(&govcdtypes.Group{
+		Name:         plan.Name.ValueString(),
+		Description:  plan.Description.ValueString(),
+		ProviderType: plan.ProviderType.ValueString(),
+		Role:         role,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating group", err.Error())
+		return
+	}
+
+	state := *plan
+	state.ID = types.StringValue(group.Group.ID)
+
+	var d diag.Diagnostics
+	state.UserNames, d = types.SetValueFrom(ctx, types.StringType, groupUserNames(group))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &groupResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.GetGroup(true)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving group", err.Error())
+		return
+	}
+
+	plan := &groupResourceModel{
+		ID:           types.StringValue(group.Group.ID),
+		Name:         types.StringValue(group.Group.Name),
+		Description:  utils.StringValueOrNull(group.Group.Description),
+		ProviderType: types.StringValue(group.Group.ProviderType),
+		RoleName:     types.StringNull(),
+	}
+
+	if group.Group.Role != nil {
+		plan.RoleName = types.StringValue(group.Group.Role.Name)
+	}
+
+	var d diag.Diagnostics
+	plan.UserNames, d = types.SetValueFrom(ctx, types.StringType, groupUserNames(group))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := &groupResourceModel{}
+
+	// Get current plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.GetGroup(false)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving group", err.Error())
+		return
+	}
+
+	role, err := r.adminOrg.GetRoleReference(plan.RoleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving role", err.Error())
+		return
+	}
+
+	group.Group.Role = role
+	group.Group.Description = plan.Description.ValueString()
+
+	if err = group.Update(); err != nil {
+		resp.Diagnostics.AddError("Error updating group", err.Error())
+		return
+	}
+
+	var d diag.Diagnostics
+	plan.UserNames, d = types.SetValueFrom(ctx, types.StringType, groupUserNames(group))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &groupResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.GetGroup(false)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving group", err.Error())
+		return
+	}
+
+	if err = group.Delete(); err != nil {
+		resp.Diagnostics.AddError("Error deleting group", err.Error())
+	}
+}
+
+func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+func (r *groupResource) GetGroup(refresh bool) (*govcd.OrgGroup, error) {
+	return r.group.GetGroup(r.adminOrg, refresh)
+}
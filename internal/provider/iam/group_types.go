@@ -0,0 +1,21 @@
+package iam
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type groupResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	RoleName     types.String `tfsdk:"role_name"`
+	Description  types.String `tfsdk:"description"`
+	ProviderType types.String `tfsdk:"provider_type"`
+	UserNames    types.Set    `tfsdk:"user_names"`
+}
+
+type groupDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	RoleName     types.String `tfsdk:"role_name"`
+	Description  types.String `tfsdk:"description"`
+	ProviderType types.String `tfsdk:"provider_type"`
+	UserNames    types.Set    `tfsdk:"user_names"`
+}
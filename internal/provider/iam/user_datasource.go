@@ -115,6 +115,13 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		StoredVMQuota:   types.Int64Value(int64(user.User.StoredVmQuota)),
 	}
 
+	var diags diag.Diagnostics
+	state.GroupNames, diags = types.SetValueFrom(ctx, types.StringType, userGroupNames(user))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 	if resp.Diagnostics.HasError() {
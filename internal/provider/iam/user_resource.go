@@ -114,6 +114,13 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	state := *plan
 	state.ID = types.StringValue(user.User.ID)
 
+	var d diag.Diagnostics
+	state.GroupNames, d = types.SetValueFrom(ctx, types.StringType, userGroupNames(user))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 	if resp.Diagnostics.HasError() {
@@ -160,6 +167,13 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		Password:        state.Password,
 	}
 
+	var d diag.Diagnostics
+	plan.GroupNames, d = types.SetValueFrom(ctx, types.StringType, userGroupNames(user))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -204,6 +218,10 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		resp.Diagnostics.AddError("Error updating user", err.Error())
 	}
 
+	var d diag.Diagnostics
+	plan.GroupNames, d = types.SetValueFrom(ctx, types.StringType, userGroupNames(user))
+	resp.Diagnostics.Append(d...)
+
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -3,6 +3,7 @@ package iam
 import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -153,6 +154,13 @@ func userSchema() superschema.Schema {
 					Computed: true,
 				},
 			},
+			"group_names": superschema.SetAttribute{
+				Common: &schemaR.SetAttribute{
+					MarkdownDescription: "The names of the groups the user belongs to.",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
 			"password": superschema.StringAttribute{
 				Resource: &schemaR.StringAttribute{
 					MarkdownDescription: "The user's password. This value is never returned on read.",
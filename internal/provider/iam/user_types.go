@@ -13,6 +13,7 @@ type userResourceModel struct {
 	Enabled         types.Bool   `tfsdk:"enabled"`
 	DeployedVMQuota types.Int64  `tfsdk:"deployed_vm_quota"`
 	StoredVMQuota   types.Int64  `tfsdk:"stored_vm_quota"`
+	GroupNames      types.Set    `tfsdk:"group_names"`
 
 	// Specific
 	Password      types.String `tfsdk:"password"`
@@ -30,6 +31,7 @@ type userDataSourceModel struct {
 	Enabled         types.Bool   `tfsdk:"enabled"`
 	DeployedVMQuota types.Int64  `tfsdk:"deployed_vm_quota"`
 	StoredVMQuota   types.Int64  `tfsdk:"stored_vm_quota"`
+	GroupNames      types.Set    `tfsdk:"group_names"`
 
 	// Specific
 	ProviderType types.String `tfsdk:"provider_type"`
@@ -1,13 +1,17 @@
 package network
 
 import (
+	"context"
+
 	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
+	commonnetwork "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
@@ -33,21 +37,26 @@ type networkIsolatedModel struct {
 	DNS2         types.String `tfsdk:"dns2"`
 	DNSSuffix    types.String `tfsdk:"dns_suffix"`
 	StaticIPPool types.Set    `tfsdk:"static_ip_pool"`
+	Metadata     types.Set    `tfsdk:"metadata"`
 }
 
 type networkRoutedModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Description     types.String `tfsdk:"description"`
-	EdgeGatewayID   types.String `tfsdk:"edge_gateway_id"`
-	EdgeGatewayName types.String `tfsdk:"edge_gateway_name"`
-	InterfaceType   types.String `tfsdk:"interface_type"`
-	Gateway         types.String `tfsdk:"gateway"`
-	PrefixLength    types.Int64  `tfsdk:"prefix_length"`
-	DNS1            types.String `tfsdk:"dns1"`
-	DNS2            types.String `tfsdk:"dns2"`
-	DNSSuffix       types.String `tfsdk:"dns_suffix"`
-	StaticIPPool    types.Set    `tfsdk:"static_ip_pool"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	EdgeGatewayID    types.String `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName  types.String `tfsdk:"edge_gateway_name"`
+	InterfaceType    types.String `tfsdk:"interface_type"`
+	Gateway          types.String `tfsdk:"gateway"`
+	PrefixLength     types.Int64  `tfsdk:"prefix_length"`
+	DNS1             types.String `tfsdk:"dns1"`
+	DNS2             types.String `tfsdk:"dns2"`
+	DNSSuffix        types.String `tfsdk:"dns_suffix"`
+	StaticIPPool     types.Set    `tfsdk:"static_ip_pool"`
+	IPv6Gateway      types.String `tfsdk:"ipv6_gateway"`
+	IPv6PrefixLength types.Int64  `tfsdk:"ipv6_prefix_length"`
+	IPv6StaticIPPool types.Set    `tfsdk:"ipv6_static_ip_pool"`
+	Metadata         types.Set    `tfsdk:"metadata"`
 }
 
 var networkMutexKV = mutex.NewKV()
@@ -68,9 +77,14 @@ func GetParentEdgeGatewayID(org org.Org, edgeGatewayID string) (*string, diag.Di
 
 // Get IP Pool information data from network.
 func GetIPRanges(network *govcd.OpenApiOrgVdcNetwork) []staticIPPool {
+	return ipRangesToStaticIPPools(network.OpenApiOrgVdcNetwork.Subnets.Values[0].IPRanges)
+}
+
+// ipRangesToStaticIPPools converts the IP ranges of a subnet to static IP pools.
+func ipRangesToStaticIPPools(ipRanges govcdtypes.OrgVdcNetworkSubnetIPRanges) []staticIPPool {
 	ipPools := []staticIPPool{}
 
-	for _, ipRange := range network.OpenApiOrgVdcNetwork.Subnets.Values[0].IPRanges.Values {
+	for _, ipRange := range ipRanges.Values {
 		ipPool := staticIPPool{
 			StartAddress: types.StringValue(ipRange.StartAddress),
 			EndAddress:   types.StringValue(ipRange.EndAddress),
@@ -80,19 +94,56 @@ func GetIPRanges(network *govcd.OpenApiOrgVdcNetwork) []staticIPPool {
 	return ipPools
 }
 
+// getRoutedSubnets returns the primary subnet and the secondary IPv6 subnet of a routed network.
+// The IPv6 subnet is nil if the dual-stack mode is not enabled on the network.
+func getRoutedSubnets(network *govcd.OpenApiOrgVdcNetwork) (primary govcdtypes.OrgVdcNetworkSubnetValues, ipv6 *govcdtypes.OrgVdcNetworkSubnetValues) {
+	subnets := network.OpenApiOrgVdcNetwork.Subnets.Values
+	primary = subnets[0]
+
+	if network.OpenApiOrgVdcNetwork.EnableDualSubnetNetwork == nil || !*network.OpenApiOrgVdcNetwork.EnableDualSubnetNetwork || len(subnets) < 2 {
+		return primary, nil
+	}
+
+	// The API does not guarantee the order of the subnets
+	if family, _ := commonnetwork.GetIPFamily(subnets[0].Gateway); family == commonnetwork.IPv6 {
+		return subnets[1], &subnets[0]
+	}
+	return primary, &subnets[1]
+}
+
 // Set data to network routed model.
-func SetDataToNetworkRoutedModel(network *govcd.OpenApiOrgVdcNetwork) networkRoutedModel {
-	return networkRoutedModel{
-		ID:              types.StringValue(network.OpenApiOrgVdcNetwork.ID),
-		Name:            types.StringValue(network.OpenApiOrgVdcNetwork.Name),
-		Description:     utils.StringValueOrNull(network.OpenApiOrgVdcNetwork.Description),
-		EdgeGatewayID:   types.StringValue(network.OpenApiOrgVdcNetwork.Connection.RouterRef.ID),
-		EdgeGatewayName: types.StringValue(network.OpenApiOrgVdcNetwork.Connection.RouterRef.Name),
-		InterfaceType:   types.StringValue(network.OpenApiOrgVdcNetwork.Connection.ConnectionType),
-		Gateway:         types.StringValue(network.OpenApiOrgVdcNetwork.Subnets.Values[0].Gateway),
-		PrefixLength:    types.Int64Value(int64(network.OpenApiOrgVdcNetwork.Subnets.Values[0].PrefixLength)),
-		DNS1:            utils.StringValueOrNull(network.OpenApiOrgVdcNetwork.Subnets.Values[0].DNSServer1),
-		DNS2:            utils.StringValueOrNull(network.OpenApiOrgVdcNetwork.Subnets.Values[0].DNSServer2),
-		DNSSuffix:       utils.StringValueOrNull(network.OpenApiOrgVdcNetwork.Subnets.Values[0].DNSSuffix),
+func SetDataToNetworkRoutedModel(ctx context.Context, network *govcd.OpenApiOrgVdcNetwork) (networkRoutedModel, diag.Diagnostics) {
+	primary, ipv6 := getRoutedSubnets(network)
+
+	data := networkRoutedModel{
+		ID:               types.StringValue(network.OpenApiOrgVdcNetwork.ID),
+		Name:             types.StringValue(network.OpenApiOrgVdcNetwork.Name),
+		Description:      utils.StringValueOrNull(network.OpenApiOrgVdcNetwork.Description),
+		EdgeGatewayID:    types.StringValue(network.OpenApiOrgVdcNetwork.Connection.RouterRef.ID),
+		EdgeGatewayName:  types.StringValue(network.OpenApiOrgVdcNetwork.Connection.RouterRef.Name),
+		InterfaceType:    types.StringValue(network.OpenApiOrgVdcNetwork.Connection.ConnectionType),
+		Gateway:          types.StringValue(primary.Gateway),
+		PrefixLength:     types.Int64Value(int64(primary.PrefixLength)),
+		DNS1:             utils.StringValueOrNull(primary.DNSServer1),
+		DNS2:             utils.StringValueOrNull(primary.DNSServer2),
+		DNSSuffix:        utils.StringValueOrNull(primary.DNSSuffix),
+		IPv6Gateway:      types.StringNull(),
+		IPv6PrefixLength: types.Int64Null(),
+		IPv6StaticIPPool: types.SetNull(types.ObjectType{AttrTypes: staticIPPoolAttrTypes}),
 	}
+
+	var diags, d diag.Diagnostics
+	data.StaticIPPool, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: staticIPPoolAttrTypes}, ipRangesToStaticIPPools(primary.IPRanges))
+	diags.Append(d...)
+
+	if ipv6 != nil {
+		data.IPv6Gateway = types.StringValue(ipv6.Gateway)
+		data.IPv6PrefixLength = types.Int64Value(int64(ipv6.PrefixLength))
+		if len(ipv6.IPRanges.Values) > 0 {
+			data.IPv6StaticIPPool, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: staticIPPoolAttrTypes}, ipRangesToStaticIPPools(ipv6.IPRanges))
+			diags.Append(d...)
+		}
+	}
+
+	return data, diags
 }
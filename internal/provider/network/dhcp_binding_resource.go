@@ -91,7 +91,10 @@ func (r *dhcpBindingResource) Create(ctx context.Context, req resource.CreateReq
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.Get())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.Get()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.OrgNetworkID.Get())
 
 	orgNetwork, err := r.org.GetOpenApiOrgVdcNetworkById(plan.OrgNetworkID.Get())
@@ -188,7 +191,10 @@ func (r *dhcpBindingResource) Update(ctx context.Context, req resource.UpdateReq
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.Get())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.Get()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.OrgNetworkID.Get())
 
 	orgNetwork, err := r.org.GetOpenApiOrgVdcNetworkById(plan.OrgNetworkID.Get())
@@ -244,7 +250,10 @@ func (r *dhcpBindingResource) Delete(ctx context.Context, req resource.DeleteReq
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.Get())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.Get()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, state.OrgNetworkID.Get())
 
 	orgNetwork, err := r.org.GetOpenApiOrgVdcNetworkById(state.OrgNetworkID.Get())
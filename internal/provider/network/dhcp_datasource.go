@@ -86,7 +86,10 @@ func (d *dhcpDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		org:    d.org,
 	}
 
-	mutex.GlobalMutex.KvLock(ctx, config.OrgNetworkID.ValueString())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, config.OrgNetworkID.ValueString()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, config.OrgNetworkID.ValueString())
 
 	// Read data from the API
@@ -96,6 +99,12 @@ func (d *dhcpDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	// Read the IPv6 configuration of the Edge Gateway of the network
+	resp.Diagnostics.Append(s.readIPv6(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
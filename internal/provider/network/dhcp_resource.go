@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
@@ -91,7 +92,10 @@ func (r *dhcpResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Implement the resource creation logic here.
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.ValueString())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.ValueString()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.OrgNetworkID.ValueString())
 
 	resp.Diagnostics.Append(r.createUpdateDHCP(ctx, plan)...)
@@ -99,6 +103,11 @@ func (r *dhcpResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(r.updateIPv6(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	state, found, d := r.read(ctx, plan)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
@@ -134,7 +143,10 @@ func (r *dhcpResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		Implement the resource read here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.ValueString())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.ValueString()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, state.OrgNetworkID.ValueString())
 
 	stateRefreshed, found, d := r.read(ctx, state)
@@ -175,7 +187,10 @@ func (r *dhcpResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Implement the resource update here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.ValueString())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.ValueString()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, plan.OrgNetworkID.ValueString())
 
 	resp.Diagnostics.Append(r.createUpdateDHCP(ctx, plan)...)
@@ -183,6 +198,11 @@ func (r *dhcpResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(r.updateIPv6(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	state, found, d := r.read(ctx, plan)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
@@ -217,11 +237,21 @@ func (r *dhcpResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		Implement the resource deletion here
 	*/
 
-	mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.ValueString())
+	if errLock := mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.ValueString()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer mutex.GlobalMutex.KvUnlock(ctx, state.OrgNetworkID.ValueString())
 
 	if err := r.org.DeleteNetworkDHCP(state.OrgNetworkID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting dhcp", err.Error())
+		return
+	}
+
+	// Disable the IPv6 address assignment if it is managed by this resource
+	if !state.IPv6Mode.IsNull() && state.IPv6Mode.ValueString() != dhcpIPv6ModeDisabled {
+		state.IPv6Mode = types.StringValue(dhcpIPv6ModeDisabled)
+		resp.Diagnostics.Append(r.updateIPv6(ctx, state)...)
 	}
 }
 
@@ -296,6 +326,9 @@ func (r *dhcpResource) read(ctx context.Context, plan *dhcpModel) (state *dhcpMo
 
 	// DHCP resource don't have an ID, so we use the OrgNetworkID
 	state.ID = types.StringValue(plan.OrgNetworkID.ValueString())
+	state.IPv6Mode = types.StringNull()
+	state.IPv6DNSServers = types.ListNull(types.StringType)
+	state.IPv6DomainNames = types.ListNull(types.StringType)
 	state.OrgNetworkID = types.StringValue(plan.OrgNetworkID.ValueString())
 	state.Mode = types.StringValue(orgNetworkDhcp.OpenApiOrgVdcNetworkDhcp.Mode)
 	state.LeaseTime = types.Int64Value(int64(*orgNetworkDhcp.OpenApiOrgVdcNetworkDhcp.LeaseTime))
@@ -327,5 +360,115 @@ func (r *dhcpResource) read(ctx context.Context, plan *dhcpModel) (state *dhcpMo
 		}
 	}
 
+	// The IPv6 configuration is only read if it is managed by this resource
+	if !plan.IPv6Mode.IsNull() {
+		diags.Append(r.readIPv6(ctx, state)...)
+	}
+
+	return
+}
+
+// getEdgeGateway returns the Edge Gateway of the routed network.
+func (r *dhcpResource) getEdgeGateway(orgNetworkID string) (egw edgegw.EdgeGateway, routed bool, diags diag.Diagnostics) {
+	orgNetwork, err := r.org.GetOpenApiOrgVdcNetworkById(orgNetworkID)
+	if err != nil {
+		diags.AddError("Error retrieving org network", err.Error())
+		return
+	}
+
+	if !orgNetwork.IsRouted() {
+		return
+	}
+
+	egw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID: types.StringValue(orgNetwork.OpenApiOrgVdcNetwork.Connection.RouterRef.ID),
+	})
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway", err.Error())
+		return
+	}
+
+	return egw, true, nil
+}
+
+// updateIPv6 updates the IPv6 address assignment mode (SLAAC profile) of the Edge Gateway of the network.
+func (r *dhcpResource) updateIPv6(ctx context.Context, rm *dhcpModel) (diags diag.Diagnostics) {
+	if rm.IPv6Mode.IsNull() {
+		return
+	}
+
+	egw, routed, d := r.getEdgeGateway(rm.OrgNetworkID.ValueString())
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	if !routed {
+		diags.AddAttributeError(path.Root("ipv6_mode"), "IPv6 mode not supported", "The IPv6 address assignment mode can only be configured on a routed network.")
+		return
+	}
+
+	slaacProfile := &govcdtypes.NsxtEdgeGatewaySlaacProfile{
+		Enabled: rm.IPv6Mode.ValueString() != dhcpIPv6ModeDisabled,
+		Mode:    rm.IPv6Mode.ValueString(),
+	}
+
+	if rm.IPv6Mode.ValueString() == dhcpIPv6ModeSLAAC {
+		slaacProfile.DNSConfig.DNSServerIpv6Addresses, d = rm.IPv6DNSServersFromPlan(ctx)
+		diags.Append(d...)
+		slaacProfile.DNSConfig.DomainNames, d = rm.IPv6DomainNamesFromPlan(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if errLock := egw.Lock(ctx); errLock != nil {
+		diags.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer egw.Unlock(ctx)
+
+	if _, err := egw.UpdateSlaacProfile(slaacProfile); err != nil {
+		diags.AddError("Error updating IPv6 mode", err.Error())
+	}
+
+	return
+}
+
+// readIPv6 reads the IPv6 address assignment mode (SLAAC profile) of the Edge Gateway of the network.
+// The IPv6 attributes are null if the network is not a routed network.
+func (r *dhcpResource) readIPv6(ctx context.Context, state *dhcpModel) (diags diag.Diagnostics) {
+	state.IPv6Mode = types.StringNull()
+	state.IPv6DNSServers = types.ListNull(types.StringType)
+	state.IPv6DomainNames = types.ListNull(types.StringType)
+
+	egw, routed, d := r.getEdgeGateway(state.OrgNetworkID.ValueString())
+	diags.Append(d...)
+	if diags.HasError() || !routed {
+		return
+	}
+
+	slaacProfile, err := egw.GetSlaacProfile()
+	if err != nil {
+		diags.AddError("Error retrieving IPv6 mode", err.Error())
+		return
+	}
+
+	state.IPv6Mode = types.StringValue(slaacProfile.Mode)
+	if !slaacProfile.Enabled {
+		state.IPv6Mode = types.StringValue(dhcpIPv6ModeDisabled)
+	}
+
+	if len(slaacProfile.DNSConfig.DNSServerIpv6Addresses) > 0 {
+		state.IPv6DNSServers, d = types.ListValueFrom(ctx, types.StringType, slaacProfile.DNSConfig.DNSServerIpv6Addresses)
+		diags.Append(d...)
+	}
+
+	if len(slaacProfile.DNSConfig.DomainNames) > 0 {
+		state.IPv6DomainNames, d = types.ListValueFrom(ctx, types.StringType, slaacProfile.DNSConfig.DomainNames)
+		diags.Append(d...)
+	}
+
 	return
 }
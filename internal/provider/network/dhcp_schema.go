@@ -20,8 +20,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+	flistvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/listvalidator"
 	fsetvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/setvalidator"
 	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
 )
 
 func dhcpSchema(_ context.Context) superschema.Schema {
@@ -122,6 +125,7 @@ func dhcpSchema(_ context.Context) superschema.Schema {
 							Required: true,
 							Validators: []validator.String{
 								fstringvalidator.IsIP(),
+								network.SameIPFamilyAs(path.MatchRelative().AtParent().AtName("start_address")),
 							},
 						},
 						DataSource: &schemaD.StringAttribute{
@@ -178,6 +182,67 @@ func dhcpSchema(_ context.Context) superschema.Schema {
 					Computed: true,
 				},
 			},
+			"ipv6_mode": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The IPv6 address assignment mode of the routed network. This configuration is applied on the Edge Gateway of the network and is shared by all its routed networks.",
+				},
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "If not set, the IPv6 configuration of the Edge Gateway is not managed.",
+					Optional:            true,
+					Validators: []validator.String{
+						fstringvalidator.OneOfWithDescription(
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       dhcpIPv6ModeSLAAC,
+								Description: "The IPv6 addresses are assigned with the Stateless Address Autoconfiguration (SLAAC).",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       dhcpIPv6ModeDHCPv6,
+								Description: "The IPv6 addresses are assigned by the DHCPv6 service. The `mode` must be `NETWORK` to serve IPv6 pools.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       dhcpIPv6ModeDisabled,
+								Description: "The IPv6 address assignment is disabled.",
+							},
+						),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"ipv6_dns_servers": superschema.ListAttribute{
+				Common: &schemaR.ListAttribute{
+					MarkdownDescription: "The IPv6 DNS servers advertised to the clients in `SLAAC` mode.",
+					ElementType:         types.StringType,
+				},
+				Resource: &schemaR.ListAttribute{
+					Optional: true,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(network.IsIPv6()),
+						flistvalidator.NullIfAttributeIsOneOf(path.MatchRoot("ipv6_mode"), []attr.Value{types.StringValue(dhcpIPv6ModeDHCPv6), types.StringValue(dhcpIPv6ModeDisabled)}),
+						listvalidator.AlsoRequires(path.MatchRoot("ipv6_mode")),
+					},
+				},
+				DataSource: &schemaD.ListAttribute{
+					Computed: true,
+				},
+			},
+			"ipv6_domain_names": superschema.ListAttribute{
+				Common: &schemaR.ListAttribute{
+					MarkdownDescription: "The domain names advertised to the clients in `SLAAC` mode.",
+					ElementType:         types.StringType,
+				},
+				Resource: &schemaR.ListAttribute{
+					Optional: true,
+					Validators: []validator.List{
+						flistvalidator.NullIfAttributeIsOneOf(path.MatchRoot("ipv6_mode"), []attr.Value{types.StringValue(dhcpIPv6ModeDHCPv6), types.StringValue(dhcpIPv6ModeDisabled)}),
+						listvalidator.AlsoRequires(path.MatchRoot("ipv6_mode")),
+					},
+				},
+				DataSource: &schemaD.ListAttribute{
+					Computed: true,
+				},
+			},
 		},
 	}
 }
@@ -9,6 +9,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+const (
+	dhcpIPv6ModeSLAAC    = "SLAAC"
+	dhcpIPv6ModeDHCPv6   = "DHCPv6"
+	dhcpIPv6ModeDisabled = "DISABLED"
+)
+
 type dhcpModel struct {
 	DNSServers        types.List   `tfsdk:"dns_servers"`
 	ID                types.String `tfsdk:"id"`
@@ -17,6 +23,9 @@ type dhcpModel struct {
 	Mode              types.String `tfsdk:"mode"`
 	OrgNetworkID      types.String `tfsdk:"org_network_id"`
 	Pools             types.Set    `tfsdk:"pools"`
+	IPv6Mode          types.String `tfsdk:"ipv6_mode"`
+	IPv6DNSServers    types.List   `tfsdk:"ipv6_dns_servers"`
+	IPv6DomainNames   types.List   `tfsdk:"ipv6_domain_names"`
 }
 
 type dhcpModelPools []dhcpModelPool
@@ -77,3 +86,17 @@ func (rm *dhcpModel) DNSServersFromPlan(ctx context.Context) (dnsServers dhcpMod
 func (dnsServers *dhcpModelDNSServers) ToPlan(ctx context.Context) (basetypes.ListValue, diag.Diagnostics) {
 	return types.ListValueFrom(ctx, types.StringType, dnsServers)
 }
+
+// IPv6DNSServersFromPlan returns the IPv6 DNS servers from the plan.
+func (rm *dhcpModel) IPv6DNSServersFromPlan(ctx context.Context) (dnsServers []string, diags diag.Diagnostics) {
+	dnsServers = make([]string, 0)
+	diags.Append(rm.IPv6DNSServers.ElementsAs(ctx, &dnsServers, false)...)
+	return dnsServers, diags
+}
+
+// IPv6DomainNamesFromPlan returns the IPv6 domain names from the plan.
+func (rm *dhcpModel) IPv6DomainNamesFromPlan(ctx context.Context) (domainNames []string, diags diag.Diagnostics) {
+	domainNames = make([]string, 0)
+	diags.Append(rm.IPv6DomainNames.ElementsAs(ctx, &domainNames, false)...)
+	return domainNames, diags
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
@@ -128,7 +129,10 @@ func (r *networkIsolatedResource) Create(ctx context.Context, req resource.Creat
 
 	// Lock VDC or VDCGroup
 	vcdMutexKV := mutex.NewKV()
-	vcdMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := vcdMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer vcdMutexKV.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
 	// Set network type
@@ -145,9 +149,20 @@ func (r *networkIsolatedResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Set metadata
+	resp.Diagnostics.Append(metadata.Update(ctx, orgNetwork, plan.Metadata, types.SetNull(plan.Metadata.ElementType(ctx)))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set Plan only for compute values
 	plan.ID = types.StringValue(orgNetwork.OpenApiOrgVdcNetwork.ID)
 	plan.VDC = types.StringValue(vdcOrVDCGroup.GetName())
+	plan.Metadata, diag = metadata.Get(ctx, orgNetwork)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -222,6 +237,13 @@ func (r *networkIsolatedResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// Set metadata
+	plan.Metadata, diags = metadata.Get(ctx, orgNetwork)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -255,7 +277,10 @@ func (r *networkIsolatedResource) Update(ctx context.Context, req resource.Updat
 
 	// Lock VDC or VDCGroup
 	vcdMutexKV := mutex.NewKV()
-	vcdMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := vcdMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer vcdMutexKV.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
 	// Get network
@@ -284,6 +309,18 @@ func (r *networkIsolatedResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	// Update metadata
+	resp.Diagnostics.Append(metadata.Update(ctx, orgNetwork, plan.Metadata, state.Metadata)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Metadata, diag = metadata.Get(ctx, orgNetwork)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -327,7 +364,10 @@ func (r *networkIsolatedResource) Delete(ctx context.Context, req resource.Delet
 
 	// Lock VDC or VDCGroup
 	vcdMutexKV := mutex.NewKV()
-	vcdMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := vcdMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer vcdMutexKV.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
 	// Delete network
@@ -399,6 +439,13 @@ func (r *networkIsolatedResource) ImportState(ctx context.Context, req resource.
 		return
 	}
 
+	// Set metadata
+	plan.Metadata, diags = metadata.Get(ctx, orgNetwork)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -8,12 +8,12 @@ import (
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
@@ -108,11 +108,14 @@ func (d *networkRoutedDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	// Set data into the model
-	data = SetDataToNetworkRoutedModel(orgNetwork)
+	data, diags := SetDataToNetworkRoutedModel(ctx, orgNetwork)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Set static IP pool
-	var diags diag.Diagnostics
-	data.StaticIPPool, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: staticIPPoolAttrTypes}, GetIPRanges(orgNetwork))
+	// Set metadata
+	data.Metadata, diags = metadata.Get(ctx, orgNetwork)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -19,6 +19,7 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
@@ -114,7 +115,10 @@ func (r *networkRoutedResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	networkMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := networkMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer networkMutexKV.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
 	// Set Network
@@ -131,10 +135,21 @@ func (r *networkRoutedResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// Set metadata
+	resp.Diagnostics.Append(metadata.Update(ctx, orgNetwork, plan.Metadata, types.SetNull(plan.Metadata.ElementType(ctx)))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set ID
 	plan.ID = types.StringValue(orgNetwork.OpenApiOrgVdcNetwork.ID)
 	plan.EdgeGatewayID = types.StringValue(edgegw.EdgeGateway.ID)
 	plan.EdgeGatewayName = types.StringValue(edgegw.EdgeGateway.Name)
+	plan.Metadata, diag = metadata.Get(ctx, orgNetwork)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
@@ -168,23 +183,16 @@ func (r *networkRoutedResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Set data into the network model
-	plan := SetDataToNetworkRoutedModel(orgNetwork)
-
-	// Set Static IP Pool
-	ipPools := []staticIPPool{}
-	if len(orgNetwork.OpenApiOrgVdcNetwork.Subnets.Values[0].IPRanges.Values) > 0 {
-		for _, ipRange := range orgNetwork.OpenApiOrgVdcNetwork.Subnets.Values[0].IPRanges.Values {
-			ipPool := staticIPPool{
-				StartAddress: types.StringValue(ipRange.StartAddress),
-				EndAddress:   types.StringValue(ipRange.EndAddress),
-			}
-			ipPools = append(ipPools, ipPool)
-		}
-	}
-	var diags diag.Diagnostics
-	plan.StaticIPPool, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: staticIPPoolAttrTypes}, ipPools)
+	plan, diags := SetDataToNetworkRoutedModel(ctx, orgNetwork)
 	resp.Diagnostics.Append(diags...)
 
+	// Set metadata
+	plan.Metadata, diags = metadata.Get(ctx, orgNetwork)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -192,9 +200,11 @@ func (r *networkRoutedResource) Read(ctx context.Context, req resource.ReadReque
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *networkRoutedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	plan := &networkRoutedModel{}
+	state := &networkRoutedModel{}
 
 	// Get current state
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -221,7 +231,10 @@ func (r *networkRoutedResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	networkMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := networkMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer networkMutexKV.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
 	// Get current network
@@ -249,6 +262,18 @@ func (r *networkRoutedResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	// Update metadata
+	resp.Diagnostics.Append(metadata.Update(ctx, orgNetwork, plan.Metadata, state.Metadata)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Metadata, diag = metadata.Get(ctx, orgNetwork)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -285,7 +310,10 @@ func (r *networkRoutedResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	networkMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID())
+	if errLock := networkMutexKV.KvLock(ctx, vdcOrVDCGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
 	defer networkMutexKV.KvUnlock(ctx, vdcOrVDCGroup.GetID())
 
 	// Get current network
@@ -376,5 +404,8 @@ func (r *networkRoutedResource) SetNetworkAPIObject(ctx context.Context, plan an
 		EdgeGatewayID:     p.EdgeGatewayID,
 		EdgegatewayName:   p.EdgeGatewayName,
 		InterfaceType:     p.InterfaceType,
+		IPv6Gateway:       p.IPv6Gateway,
+		IPv6PrefixLength:  p.IPv6PrefixLength,
+		IPv6StaticIPPool:  p.IPv6StaticIPPool,
 	})
 }
@@ -0,0 +1,273 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &segmentProfileResource{}
+	_ resource.ResourceWithConfigure   = &segmentProfileResource{}
+	_ resource.ResourceWithImportState = &segmentProfileResource{}
+)
+
+// NewSegmentProfileResource is a helper function to simplify the provider implementation.
+func NewSegmentProfileResource() resource.Resource {
+	return &segmentProfileResource{}
+}
+
+// segmentProfileResource is the resource implementation.
+type segmentProfileResource struct {
+	client *client.CloudAvenue
+	org    org.Org
+}
+
+// Init Initializes the resource.
+func (r *segmentProfileResource) Init(_ context.Context, _ *segmentProfileModel) (diags diag.Diagnostics) {
+	r.org, diags = org.Init(r.client)
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *segmentProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_segment_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *segmentProfileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = segmentProfileSchema(ctx).GetResource(ctx)
+}
+
+func (r *segmentProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *segmentProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &segmentProfileModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if errLock := mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.ValueString()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer mutex.GlobalMutex.KvUnlock(ctx, plan.OrgNetworkID.ValueString())
+
+	// The segment profiles can only be applied on a routed or isolated network
+	orgNetwork, err := r.org.GetOpenApiOrgVdcNetworkById(plan.OrgNetworkID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving org network", err.Error())
+		return
+	}
+
+	if _, err := network.GetKind(orgNetwork.OpenApiOrgVdcNetwork); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("org_network_id"), "Unsupported org network", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.createUpdate(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddError("Segment profiles not found after creation", "After applying the segment profiles, the API returned entity not found")
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *segmentProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &segmentProfileModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRefreshed, found, d := r.read(ctx, state)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *segmentProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := &segmentProfileModel{}
+
+	// Get current plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if errLock := mutex.GlobalMutex.KvLock(ctx, plan.OrgNetworkID.ValueString()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer mutex.GlobalMutex.KvUnlock(ctx, plan.OrgNetworkID.ValueString())
+
+	resp.Diagnostics.Append(r.createUpdate(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, found, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete resets the segment profiles of the network to the default profiles.
+func (r *segmentProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &segmentProfileModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if errLock := mutex.GlobalMutex.KvLock(ctx, state.OrgNetworkID.ValueString()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer mutex.GlobalMutex.KvUnlock(ctx, state.OrgNetworkID.ValueString())
+
+	if _, err := r.client.UpdateOrgVDCNetworkSegmentProfiles(state.OrgNetworkID.ValueString(), &client.OrgVDCNetworkSegmentProfiles{}); err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error resetting segment profiles", err.Error())
+	}
+}
+
+func (r *segmentProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format : orgNetworkID
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_network_id"), req.ID)...)
+}
+
+// createUpdate applies the segment profiles to the network. The API has no create method, the update method is used.
+func (r *segmentProfileResource) createUpdate(_ context.Context, rm *segmentProfileModel) (diags diag.Diagnostics) {
+	if _, err := r.client.UpdateOrgVDCNetworkSegmentProfiles(rm.OrgNetworkID.ValueString(), rm.toOrgVDCNetworkSegmentProfiles()); err != nil {
+		diags.AddError("Error applying segment profiles", err.Error())
+	}
+
+	return
+}
+
+// read returns the segment profiles of the network. found is false if the network does not exist.
+func (r *segmentProfileResource) read(_ context.Context, rm *segmentProfileModel) (state *segmentProfileModel, found bool, diags diag.Diagnostics) {
+	segmentProfiles, err := r.client.GetOrgVDCNetworkSegmentProfiles(rm.OrgNetworkID.ValueString())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving segment profiles", err.Error())
+		return nil, true, diags
+	}
+
+	state = &segmentProfileModel{
+		ID:                       types.StringValue(rm.OrgNetworkID.ValueString()),
+		OrgNetworkID:             types.StringValue(rm.OrgNetworkID.ValueString()),
+		SegmentProfileTemplateID: types.StringNull(),
+		IPDiscoveryProfileID:     fromOpenAPIReference(segmentProfiles.IPDiscoveryProfile),
+		MACDiscoveryProfileID:    fromOpenAPIReference(segmentProfiles.MACDiscoveryProfile),
+		SpoofGuardProfileID:      fromOpenAPIReference(segmentProfiles.SpoofGuardProfile),
+		QoSProfileID:             fromOpenAPIReference(segmentProfiles.QoSProfile),
+		SegmentSecurityProfileID: fromOpenAPIReference(segmentProfiles.SegmentSecurityProfile),
+	}
+
+	// The template may be inherited from the VDC or the global configuration,
+	// it is only read if it is managed by this resource.
+	if !rm.SegmentProfileTemplateID.IsNull() && segmentProfiles.SegmentProfileTemplate != nil {
+		state.SegmentProfileTemplateID = fromOpenAPIReference(segmentProfiles.SegmentProfileTemplate.TemplateRef)
+	}
+
+	return state, true, nil
+}
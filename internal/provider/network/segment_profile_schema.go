@@ -0,0 +1,150 @@
+package network
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
+)
+
+// segmentProfileIDAttribute returns the schema of the ID of a segment profile.
+func segmentProfileIDAttribute(profileName string) superschema.StringAttribute {
+	return superschema.StringAttribute{
+		Resource: &schemaR.StringAttribute{
+			MarkdownDescription: "The ID of the " + profileName + " profile applied to the network. If not set, the default profile is applied.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("segment_profile_template_id")),
+			},
+		},
+	}
+}
+
+func segmentProfileSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The `network_segment_profile` resource allows you to bind custom NSX-T segment profiles to a routed or isolated Org Network. A segment profile template or individual segment profiles can be applied. The available profiles can be listed with the `cloudavenue_network_segment_profile_templates` and `cloudavenue_network_segment_profiles` data sources.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the segment profile configuration. It is the ID of the Org Network.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"org_network_id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the routed or isolated Org Network.",
+					Required:            true,
+					Validators: []validator.String{
+						fstringvalidator.IsURN(),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"segment_profile_template_id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the segment profile template applied to the network. The template defines all the segment profiles of the network.",
+					Optional:            true,
+				},
+			},
+			"ip_discovery_profile_id":     segmentProfileIDAttribute("IP discovery"),
+			"mac_discovery_profile_id":    segmentProfileIDAttribute("MAC discovery"),
+			"spoof_guard_profile_id":      segmentProfileIDAttribute("spoof guard"),
+			"qos_profile_id":              segmentProfileIDAttribute("QoS"),
+			"segment_security_profile_id": segmentProfileIDAttribute("segment security"),
+		},
+	}
+}
+
+// segmentProfileListAttribute returns the schema of a list of segment profiles or templates.
+func segmentProfileListAttribute(description string) superschema.ListNestedAttribute {
+	return superschema.ListNestedAttribute{
+		DataSource: &schemaD.ListNestedAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		},
+		Attributes: superschema.Attributes{
+			"id": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The ID of the profile.",
+					Computed:            true,
+				},
+			},
+			"name": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The name of the profile.",
+					Computed:            true,
+				},
+			},
+			"description": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The description of the profile.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func segmentProfileTemplatesSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "The `network_segment_profile_templates` data source allows you to list the NSX-T segment profile templates available in the organization. A template can be applied to an Org Network with the `cloudavenue_network_segment_profile` resource.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The ID of the data source.",
+					Computed:            true,
+				},
+			},
+			"segment_profile_templates": segmentProfileListAttribute("The list of segment profile templates."),
+		},
+	}
+}
+
+func segmentProfilesSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "The `network_segment_profiles` data source allows you to list the NSX-T segment profiles available in a VDC or a VDC Group. A profile can be applied to an Org Network with the `cloudavenue_network_segment_profile` resource.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The ID of the data source.",
+					Computed:            true,
+				},
+			},
+			"vdc": superschema.StringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The name of the VDC or the VDC Group. If not defined, the VDC of the provider is used.",
+					Optional:            true,
+					Computed:            true,
+				},
+			},
+			"ip_discovery_profiles":     segmentProfileListAttribute("The list of IP discovery profiles."),
+			"mac_discovery_profiles":    segmentProfileListAttribute("The list of MAC discovery profiles."),
+			"spoof_guard_profiles":      segmentProfileListAttribute("The list of spoof guard profiles."),
+			"qos_profiles":              segmentProfileListAttribute("The list of QoS profiles."),
+			"segment_security_profiles": segmentProfileListAttribute("The list of segment security profiles."),
+		},
+	}
+}
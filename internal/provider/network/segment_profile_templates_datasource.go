@@ -0,0 +1,98 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+var (
+	_ datasource.DataSource              = &segmentProfileTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &segmentProfileTemplatesDataSource{}
+)
+
+// NewSegmentProfileTemplatesDataSource returns a new data source listing the segment profile templates.
+func NewSegmentProfileTemplatesDataSource() datasource.DataSource {
+	return &segmentProfileTemplatesDataSource{}
+}
+
+type segmentProfileTemplatesDataSource struct {
+	client *client.CloudAvenue
+}
+
+func (d *segmentProfileTemplatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_segment_profile_templates"
+}
+
+func (d *segmentProfileTemplatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = segmentProfileTemplatesSchema(ctx).GetDataSource(ctx)
+}
+
+func (d *segmentProfileTemplatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *segmentProfileTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	config := &segmentProfileTemplatesDataSourceModel{}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templates, err := d.client.GetAllSegmentProfileTemplates()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving segment profile templates", err.Error())
+		return
+	}
+
+	var (
+		profiles = make([]segmentProfileDataSourceModelProfile, 0, len(templates))
+		ids      = make([]string, 0, len(templates))
+		diags    diag.Diagnostics
+	)
+
+	for _, template := range templates {
+		profiles = append(profiles, segmentProfileDataSourceModelProfile{
+			ID:          types.StringValue(template.ID),
+			Name:        types.StringValue(template.Name),
+			Description: types.StringValue(template.Description),
+		})
+		ids = append(ids, template.ID)
+	}
+
+	state := &segmentProfileTemplatesDataSourceModel{
+		ID: utils.GenerateUUID(ids),
+	}
+
+	state.SegmentProfileTemplates, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: segmentProfileDataSourceModelProfileAttrTypes}, profiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,84 @@
+package network
+
+import (
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+)
+
+type segmentProfileModel struct {
+	ID                       types.String `tfsdk:"id"`
+	OrgNetworkID             types.String `tfsdk:"org_network_id"`
+	SegmentProfileTemplateID types.String `tfsdk:"segment_profile_template_id"`
+	IPDiscoveryProfileID     types.String `tfsdk:"ip_discovery_profile_id"`
+	MACDiscoveryProfileID    types.String `tfsdk:"mac_discovery_profile_id"`
+	SpoofGuardProfileID      types.String `tfsdk:"spoof_guard_profile_id"`
+	QoSProfileID             types.String `tfsdk:"qos_profile_id"`
+	SegmentSecurityProfileID types.String `tfsdk:"segment_security_profile_id"`
+}
+
+// toOpenAPIReference returns a reference to the given ID, or nil if the ID is not set.
+func toOpenAPIReference(id types.String) *govcdtypes.OpenApiReference {
+	if id.IsNull() || id.IsUnknown() || id.ValueString() == "" {
+		return nil
+	}
+	return &govcdtypes.OpenApiReference{ID: id.ValueString()}
+}
+
+// fromOpenAPIReference returns the ID of the given reference, or null if the reference is not set.
+func fromOpenAPIReference(ref *govcdtypes.OpenApiReference) types.String {
+	if ref == nil || ref.ID == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(ref.ID)
+}
+
+// toOrgVDCNetworkSegmentProfiles converts the model to the API object.
+// If a segment profile template is set, the individual profiles are defined by the template.
+func (rm *segmentProfileModel) toOrgVDCNetworkSegmentProfiles() *client.OrgVDCNetworkSegmentProfiles {
+	if ref := toOpenAPIReference(rm.SegmentProfileTemplateID); ref != nil {
+		return &client.OrgVDCNetworkSegmentProfiles{
+			SegmentProfileTemplate: &client.OrgVDCNetworkSegmentProfileTemplateRef{
+				TemplateRef: ref,
+			},
+		}
+	}
+
+	return &client.OrgVDCNetworkSegmentProfiles{
+		IPDiscoveryProfile:     toOpenAPIReference(rm.IPDiscoveryProfileID),
+		MACDiscoveryProfile:    toOpenAPIReference(rm.MACDiscoveryProfileID),
+		SpoofGuardProfile:      toOpenAPIReference(rm.SpoofGuardProfileID),
+		QoSProfile:             toOpenAPIReference(rm.QoSProfileID),
+		SegmentSecurityProfile: toOpenAPIReference(rm.SegmentSecurityProfileID),
+	}
+}
+
+type segmentProfileTemplatesDataSourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	SegmentProfileTemplates types.List   `tfsdk:"segment_profile_templates"`
+}
+
+type segmentProfilesDataSourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	VDC                     types.String `tfsdk:"vdc"`
+	IPDiscoveryProfiles     types.List   `tfsdk:"ip_discovery_profiles"`
+	MACDiscoveryProfiles    types.List   `tfsdk:"mac_discovery_profiles"`
+	SpoofGuardProfiles      types.List   `tfsdk:"spoof_guard_profiles"`
+	QoSProfiles             types.List   `tfsdk:"qos_profiles"`
+	SegmentSecurityProfiles types.List   `tfsdk:"segment_security_profiles"`
+}
+
+type segmentProfileDataSourceModelProfile struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+var segmentProfileDataSourceModelProfileAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"description": types.StringType,
+}
@@ -0,0 +1,111 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+var (
+	_ datasource.DataSource              = &segmentProfilesDataSource{}
+	_ datasource.DataSourceWithConfigure = &segmentProfilesDataSource{}
+)
+
+// NewSegmentProfilesDataSource returns a new data source listing the segment profiles.
+func NewSegmentProfilesDataSource() datasource.DataSource {
+	return &segmentProfilesDataSource{}
+}
+
+type segmentProfilesDataSource struct {
+	client *client.CloudAvenue
+}
+
+func (d *segmentProfilesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_segment_profiles"
+}
+
+func (d *segmentProfilesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = segmentProfilesSchema(ctx).GetDataSource(ctx)
+}
+
+func (d *segmentProfilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *segmentProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	config := &segmentProfilesDataSourceModel{}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vdcOrVDCGroup, err := d.client.GetVDCOrVDCGroup(config.VDC.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving VDC or VDCGroup", err.Error())
+		return
+	}
+
+	state := &segmentProfilesDataSourceModel{
+		VDC: types.StringValue(vdcOrVDCGroup.GetName()),
+	}
+
+	ids := make([]string, 0)
+
+	for profileType, list := range map[client.SegmentProfileType]*types.List{
+		client.SegmentProfileTypeIPDiscovery:     &state.IPDiscoveryProfiles,
+		client.SegmentProfileTypeMACDiscovery:    &state.MACDiscoveryProfiles,
+		client.SegmentProfileTypeSpoofGuard:      &state.SpoofGuardProfiles,
+		client.SegmentProfileTypeQoS:             &state.QoSProfiles,
+		client.SegmentProfileTypeSegmentSecurity: &state.SegmentSecurityProfiles,
+	} {
+		segmentProfiles, err := d.client.GetAllSegmentProfiles(profileType, vdcOrVDCGroup)
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving segment profiles", fmt.Sprintf("Error retrieving %s: %s", profileType, err))
+			return
+		}
+
+		profiles := make([]segmentProfileDataSourceModelProfile, 0, len(segmentProfiles))
+		for _, segmentProfile := range segmentProfiles {
+			profiles = append(profiles, segmentProfileDataSourceModelProfile{
+				ID:          types.StringValue(segmentProfile.ID),
+				Name:        types.StringValue(segmentProfile.Name),
+				Description: types.StringValue(segmentProfile.Description),
+			})
+			ids = append(ids, segmentProfile.ID)
+		}
+
+		value, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: segmentProfileDataSourceModelProfileAttrTypes}, profiles)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		*list = value
+	}
+
+	state.ID = utils.GenerateUUID(ids)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
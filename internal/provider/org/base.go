@@ -0,0 +1,6 @@
+// Package org provides Terraform resources to manage the organization.
+package org
+
+const (
+	categoryName = "org"
+)
@@ -0,0 +1,338 @@
+package org
+
+import (
+	"context"
+	"fmt"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &orgSettingsResource{}
+	_ resource.ResourceWithConfigure   = &orgSettingsResource{}
+	_ resource.ResourceWithImportState = &orgSettingsResource{}
+)
+
+// NewOrgSettingsResource is a helper function to simplify the provider implementation.
+func NewOrgSettingsResource() resource.Resource {
+	return &orgSettingsResource{}
+}
+
+// orgSettingsResource is the resource implementation.
+type orgSettingsResource struct {
+	client   *client.CloudAvenue
+	adminOrg *client.AdminOrg
+}
+
+// Init Initializes the resource.
+func (r *orgSettingsResource) Init(_ context.Context, _ *orgSettingsResourceModel) (diags diag.Diagnostics) {
+	var err error
+
+	r.adminOrg, err = r.client.GetAdminOrg()
+	if err != nil {
+		diags.AddError("Unable to get ORG", err.Error())
+	}
+
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *orgSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *orgSettingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = orgSettingsSchema().GetResource(ctx)
+}
+
+func (r *orgSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create applies the settings to the organization and sets the initial Terraform state.
+func (r *orgSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &orgSettingsResourceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.createOrUpdate(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, d := r.read(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *orgSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &orgSettingsResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRefreshed, d := r.read(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the settings of the organization and sets the updated Terraform state on success.
+func (r *orgSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := &orgSettingsResourceModel{}
+
+	// Get current plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.createOrUpdate(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, d := r.read(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete removes the resource from the Terraform state.
+// The settings of the organization cannot be deleted, they are kept unchanged.
+func (r *orgSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *orgSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format : any value, the settings of the organization of the provider are imported.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// int64OrCurrent returns the value as an int pointer, or the current value if the value is not known.
+func int64OrCurrent(value types.Int64, current *int) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return current
+	}
+	return utils.TakeIntPointer(int(value.ValueInt64()))
+}
+
+// boolOrCurrent returns the value as a bool pointer, or the current value if the value is not known.
+func boolOrCurrent(value types.Bool, current *bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return current
+	}
+	return utils.TakeBoolPointer(value.ValueBool())
+}
+
+// createOrUpdate applies the settings defined in the model to the organization.
+// The settings that are not known are kept unchanged.
+func (r *orgSettingsResource) createOrUpdate(ctx context.Context, rm *orgSettingsResourceModel) (diags diag.Diagnostics) {
+	objectAsOptions := basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	}
+
+	if !rm.VAppLease.IsNull() && !rm.VAppLease.IsUnknown() {
+		lease := &orgSettingsVAppLeaseModel{}
+		diags.Append(rm.VAppLease.As(ctx, lease, objectAsOptions)...)
+		if diags.HasError() {
+			return
+		}
+
+		current := r.adminOrg.GetOrgVAppLeaseSettings()
+		if err := r.adminOrg.UpdateOrgVAppLeaseSettings(&govcdtypes.VAppLeaseSettings{
+			DeploymentLeaseSeconds:           int64OrCurrent(lease.RuntimeLeaseInSec, current.DeploymentLeaseSeconds),
+			StorageLeaseSeconds:              int64OrCurrent(lease.StorageLeaseInSec, current.StorageLeaseSeconds),
+			DeleteOnStorageLeaseExpiration:   boolOrCurrent(lease.DeleteOnStorageLeaseExpiration, current.DeleteOnStorageLeaseExpiration),
+			PowerOffOnRuntimeLeaseExpiration: boolOrCurrent(lease.PowerOffOnRuntimeLeaseExpiration, current.PowerOffOnRuntimeLeaseExpiration),
+		}); err != nil {
+			diags.AddError("Error updating vApp lease settings", err.Error())
+			return
+		}
+	}
+
+	if !rm.VAppTemplateLease.IsNull() && !rm.VAppTemplateLease.IsUnknown() {
+		lease := &orgSettingsVAppTemplateLeaseModel{}
+		diags.Append(rm.VAppTemplateLease.As(ctx, lease, objectAsOptions)...)
+		if diags.HasError() {
+			return
+		}
+
+		current := r.adminOrg.GetOrgVAppTemplateLeaseSettings()
+		if err := r.adminOrg.UpdateOrgVAppTemplateLeaseSettings(&govcdtypes.VAppTemplateLeaseSettings{
+			StorageLeaseSeconds:            int64OrCurrent(lease.StorageLeaseInSec, current.StorageLeaseSeconds),
+			DeleteOnStorageLeaseExpiration: boolOrCurrent(lease.DeleteOnStorageLeaseExpiration, current.DeleteOnStorageLeaseExpiration),
+		}); err != nil {
+			diags.AddError("Error updating vApp Template lease settings", err.Error())
+			return
+		}
+	}
+
+	deployedVMQuotaKnown := !rm.DeployedVMQuota.IsNull() && !rm.DeployedVMQuota.IsUnknown()
+	storedVMQuotaKnown := !rm.StoredVMQuota.IsNull() && !rm.StoredVMQuota.IsUnknown()
+
+	if deployedVMQuotaKnown || storedVMQuotaKnown {
+		// The other general settings are kept unchanged.
+		generalSettings := *r.adminOrg.GetOrgGeneralSettings()
+		if deployedVMQuotaKnown {
+			generalSettings.DeployedVMQuota = int(rm.DeployedVMQuota.ValueInt64())
+		}
+		if storedVMQuotaKnown {
+			generalSettings.StoredVMQuota = int(rm.StoredVMQuota.ValueInt64())
+		}
+
+		if err := r.adminOrg.UpdateOrgGeneralSettings(&generalSettings); err != nil {
+			diags.AddError("Error updating general settings", err.Error())
+			return
+		}
+	}
+
+	return
+}
+
+// read returns the settings of the organization.
+func (r *orgSettingsResource) read(ctx context.Context) (state *orgSettingsResourceModel, diags diag.Diagnostics) {
+	var d diag.Diagnostics
+
+	state = &orgSettingsResourceModel{
+		ID: types.StringValue(r.adminOrg.AdminOrg.AdminOrg.ID),
+	}
+
+	vAppLease := r.adminOrg.GetOrgVAppLeaseSettings()
+	state.VAppLease, d = types.ObjectValueFrom(ctx, orgSettingsVAppLeaseAttrTypes, orgSettingsVAppLeaseModel{
+		RuntimeLeaseInSec:                types.Int64Value(int64(derefInt(vAppLease.DeploymentLeaseSeconds))),
+		StorageLeaseInSec:                types.Int64Value(int64(derefInt(vAppLease.StorageLeaseSeconds))),
+		DeleteOnStorageLeaseExpiration:   types.BoolValue(derefBool(vAppLease.DeleteOnStorageLeaseExpiration)),
+		PowerOffOnRuntimeLeaseExpiration: types.BoolValue(derefBool(vAppLease.PowerOffOnRuntimeLeaseExpiration)),
+	})
+	diags.Append(d...)
+
+	vAppTemplateLease := r.adminOrg.GetOrgVAppTemplateLeaseSettings()
+	state.VAppTemplateLease, d = types.ObjectValueFrom(ctx, orgSettingsVAppTemplateLeaseAttrTypes, orgSettingsVAppTemplateLeaseModel{
+		StorageLeaseInSec:              types.Int64Value(int64(derefInt(vAppTemplateLease.StorageLeaseSeconds))),
+		DeleteOnStorageLeaseExpiration: types.BoolValue(derefBool(vAppTemplateLease.DeleteOnStorageLeaseExpiration)),
+	})
+	diags.Append(d...)
+
+	generalSettings := r.adminOrg.GetOrgGeneralSettings()
+	state.DeployedVMQuota = types.Int64Value(int64(generalSettings.DeployedVMQuota))
+	state.StoredVMQuota = types.Int64Value(int64(generalSettings.StoredVMQuota))
+
+	ldapSettings, err := r.adminOrg.GetLdapConfiguration()
+	if err != nil {
+		diags.AddError("Error retrieving LDAP settings", err.Error())
+		return nil, diags
+	}
+
+	ldap := orgSettingsLDAPModel{
+		Mode:          types.StringValue(ldapSettings.OrgLdapMode),
+		CustomUsersOU: utils.StringValueOrNull(ldapSettings.CustomUsersOu),
+		Hostname:      types.StringNull(),
+		Port:          types.Int64Null(),
+		SearchBase:    types.StringNull(),
+		ConnectorType: types.StringNull(),
+	}
+	if custom := ldapSettings.CustomOrgLdapSettings; custom != nil {
+		ldap.Hostname = utils.StringValueOrNull(custom.HostName)
+		ldap.Port = types.Int64Value(int64(custom.Port))
+		ldap.SearchBase = utils.StringValueOrNull(custom.SearchBase)
+		ldap.ConnectorType = utils.StringValueOrNull(custom.ConnectorType)
+	}
+	state.LDAP, d = types.ObjectValueFrom(ctx, orgSettingsLDAPAttrTypes, ldap)
+	diags.Append(d...)
+
+	samlSettings, err := r.adminOrg.GetFederationSettings()
+	if err != nil {
+		diags.AddError("Error retrieving SAML settings", err.Error())
+		return nil, diags
+	}
+
+	state.SAML, d = types.ObjectValueFrom(ctx, orgSettingsSAMLAttrTypes, orgSettingsSAMLModel{
+		Enabled:               types.BoolValue(samlSettings.Enabled),
+		EntityID:              utils.StringValueOrNull(samlSettings.SamlSPEntityID),
+		CertificateExpiration: utils.StringValueOrNull(samlSettings.CertificateExpiration),
+	})
+	diags.Append(d...)
+
+	return state, diags
+}
+
+// derefInt returns the value of the pointer, or 0 if the pointer is nil.
+func derefInt(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+// derefBool returns the value of the pointer, or false if the pointer is nil.
+func derefBool(value *bool) bool {
+	if value == nil {
+		return false
+	}
+	return *value
+}
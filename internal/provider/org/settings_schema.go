@@ -0,0 +1,162 @@
+package org
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+)
+
+// leaseInSecAttribute returns the schema of a lease duration.
+func leaseInSecAttribute(description string) superschema.Int64Attribute {
+	return superschema.Int64Attribute{
+		Resource: &schemaR.Int64Attribute{
+			MarkdownDescription: description + " 0 means never expires. If not set, the current value of the organization is kept.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// settingsBoolAttribute returns the schema of a boolean setting.
+func settingsBoolAttribute(description string) superschema.BoolAttribute {
+	return superschema.BoolAttribute{
+		Resource: &schemaR.BoolAttribute{
+			MarkdownDescription: description + " If not set, the current value of the organization is kept.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// vmQuotaAttribute returns the schema of a VM quota.
+func vmQuotaAttribute(description string) superschema.Int64Attribute {
+	return superschema.Int64Attribute{
+		Resource: &schemaR.Int64Attribute{
+			MarkdownDescription: description + " 0 means unlimited. If not set, the current value of the organization is kept.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// computedStringAttribute returns the schema of a read-only string.
+func computedStringAttribute(description string) superschema.StringAttribute {
+	return superschema.StringAttribute{
+		Resource: &schemaR.StringAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		},
+	}
+}
+
+func orgSettingsSchema() superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The `org_settings` resource allows you to manage the settings of the organization: the default leases of the vApps and vApp Templates and the VM quotas. The LDAP and SAML configurations of the organization are exposed as read-only attributes.\n\n" +
+				"~> **Note:** The settings of the organization always exist. Destroying this resource only removes it from the Terraform state, the settings are kept unchanged.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the organization.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"vapp_lease": superschema.SingleNestedAttribute{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The default lease settings of the vApps of the organization.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.Object{
+						objectplanmodifier.UseStateForUnknown(),
+					},
+				},
+				Attributes: map[string]superschema.Attribute{
+					"runtime_lease_in_sec":                  leaseInSecAttribute("How long the vApps can run before they are automatically powered off or suspended."),
+					"storage_lease_in_sec":                  leaseInSecAttribute("How long the stopped vApps are available before being automatically cleaned up."),
+					"delete_on_storage_lease_expiration":    settingsBoolAttribute("If true, the vApps are deleted when their storage lease expires. Otherwise they are marked as expired."),
+					"power_off_on_runtime_lease_expiration": settingsBoolAttribute("If true, the vApps are powered off when their runtime lease expires. Otherwise they are suspended."),
+				},
+			},
+			"vapp_template_lease": superschema.SingleNestedAttribute{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The default lease settings of the vApp Templates of the organization.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.Object{
+						objectplanmodifier.UseStateForUnknown(),
+					},
+				},
+				Attributes: map[string]superschema.Attribute{
+					"storage_lease_in_sec":               leaseInSecAttribute("How long the vApp Templates are available before being automatically cleaned up."),
+					"delete_on_storage_lease_expiration": settingsBoolAttribute("If true, the vApp Templates are deleted when their storage lease expires. Otherwise they are marked as expired."),
+				},
+			},
+			"deployed_vm_quota": vmQuotaAttribute("The maximum number of VMs that can be deployed simultaneously by a user of the organization."),
+			"stored_vm_quota":   vmQuotaAttribute("The maximum number of VMs that can be stored by a user of the organization."),
+			"ldap": superschema.SingleNestedAttribute{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The LDAP configuration of the organization.",
+					Computed:            true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"mode":            computedStringAttribute("The LDAP mode of the organization (`NONE`, `SYSTEM` or `CUSTOM`)."),
+					"custom_users_ou": computedStringAttribute("The organizational unit of the users when the mode is `SYSTEM`."),
+					"hostname":        computedStringAttribute("The hostname of the LDAP server when the mode is `CUSTOM`."),
+					"port": superschema.Int64Attribute{
+						Resource: &schemaR.Int64Attribute{
+							MarkdownDescription: "The port of the LDAP server when the mode is `CUSTOM`.",
+							Computed:            true,
+						},
+					},
+					"search_base":    computedStringAttribute("The base distinguished name used to search the users when the mode is `CUSTOM`."),
+					"connector_type": computedStringAttribute("The type of the LDAP server (`ACTIVE_DIRECTORY` or `OPEN_LDAP`) when the mode is `CUSTOM`."),
+				},
+			},
+			"saml": superschema.SingleNestedAttribute{
+				Resource: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The SAML configuration of the organization.",
+					Computed:            true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"enabled": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Whether the SAML identity provider is enabled.",
+							Computed:            true,
+						},
+					},
+					"entity_id":              computedStringAttribute("The SAML service provider entity ID of the organization."),
+					"certificate_expiration": computedStringAttribute("The expiration date of the SAML certificate."),
+				},
+			},
+		},
+	}
+}
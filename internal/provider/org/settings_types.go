@@ -0,0 +1,70 @@
+package org
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type orgSettingsResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	VAppLease         types.Object `tfsdk:"vapp_lease"`
+	VAppTemplateLease types.Object `tfsdk:"vapp_template_lease"`
+	DeployedVMQuota   types.Int64  `tfsdk:"deployed_vm_quota"`
+	StoredVMQuota     types.Int64  `tfsdk:"stored_vm_quota"`
+	LDAP              types.Object `tfsdk:"ldap"`
+	SAML              types.Object `tfsdk:"saml"`
+}
+
+type orgSettingsVAppLeaseModel struct {
+	RuntimeLeaseInSec                types.Int64 `tfsdk:"runtime_lease_in_sec"`
+	StorageLeaseInSec                types.Int64 `tfsdk:"storage_lease_in_sec"`
+	DeleteOnStorageLeaseExpiration   types.Bool  `tfsdk:"delete_on_storage_lease_expiration"`
+	PowerOffOnRuntimeLeaseExpiration types.Bool  `tfsdk:"power_off_on_runtime_lease_expiration"`
+}
+
+var orgSettingsVAppLeaseAttrTypes = map[string]attr.Type{
+	"runtime_lease_in_sec":                  types.Int64Type,
+	"storage_lease_in_sec":                  types.Int64Type,
+	"delete_on_storage_lease_expiration":    types.BoolType,
+	"power_off_on_runtime_lease_expiration": types.BoolType,
+}
+
+type orgSettingsVAppTemplateLeaseModel struct {
+	StorageLeaseInSec              types.Int64 `tfsdk:"storage_lease_in_sec"`
+	DeleteOnStorageLeaseExpiration types.Bool  `tfsdk:"delete_on_storage_lease_expiration"`
+}
+
+var orgSettingsVAppTemplateLeaseAttrTypes = map[string]attr.Type{
+	"storage_lease_in_sec":               types.Int64Type,
+	"delete_on_storage_lease_expiration": types.BoolType,
+}
+
+type orgSettingsLDAPModel struct {
+	Mode          types.String `tfsdk:"mode"`
+	CustomUsersOU types.String `tfsdk:"custom_users_ou"`
+	Hostname      types.String `tfsdk:"hostname"`
+	Port          types.Int64  `tfsdk:"port"`
+	SearchBase    types.String `tfsdk:"search_base"`
+	ConnectorType types.String `tfsdk:"connector_type"`
+}
+
+var orgSettingsLDAPAttrTypes = map[string]attr.Type{
+	"mode":            types.StringType,
+	"custom_users_ou": types.StringType,
+	"hostname":        types.StringType,
+	"port":            types.Int64Type,
+	"search_base":     types.StringType,
+	"connector_type":  types.StringType,
+}
+
+type orgSettingsSAMLModel struct {
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	EntityID              types.String `tfsdk:"entity_id"`
+	CertificateExpiration types.String `tfsdk:"certificate_expiration"`
+}
+
+var orgSettingsSAMLAttrTypes = map[string]attr.Type{
+	"enabled":                types.BoolType,
+	"entity_id":              types.StringType,
+	"certificate_expiration": types.StringType,
+}
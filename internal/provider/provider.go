@@ -4,9 +4,13 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"regexp"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -23,9 +28,12 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/alb"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/catalog"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/certificate"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/disk"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/edgegw"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/iam"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/network"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/publicip"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/storage"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/vapp"
@@ -52,8 +60,19 @@ type cloudavenueProviderModel struct {
 	URL      types.String `tfsdk:"url"`
 	User     types.String `tfsdk:"user"`
 	Password types.String `tfsdk:"password"`
+	APIToken types.String `tfsdk:"api_token"`
+	Token    types.String `tfsdk:"token"`
 	Org      types.String `tfsdk:"org"`
 	VDC      types.String `tfsdk:"vdc"`
+
+	MaxRetries   types.Int64 `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64 `tfsdk:"retry_max_wait"`
+
+	ProxyURL    types.String `tfsdk:"proxy_url"`
+	CAFile      types.String `tfsdk:"ca_file"`
+	CAPEM       types.String `tfsdk:"ca_pem"`
+	Insecure    types.Bool   `tfsdk:"insecure"`
+	HTTPTimeout types.Int64  `tfsdk:"http_timeout"`
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -61,6 +80,7 @@ func (p *cloudavenueProvider) DataSources(_ context.Context) []func() datasource
 	return []func() datasource.DataSource{
 		// ALB
 		alb.NewAlbPoolDataSource,
+		alb.NewAlbVirtualServiceDataSource,
 
 		// TIER0
 		vrf.NewTier0VrfsDataSource,
@@ -77,6 +97,8 @@ func (p *cloudavenueProvider) DataSources(_ context.Context) []func() datasource
 		edgegw.NewIPSetDataSource,
 		edgegw.NewDhcpForwardingDataSource,
 		edgegw.NewStaticRouteDataSource,
+		edgegw.NewNATRuleDataSource,
+		edgegw.NewVPNIPSecDataSource,
 
 		// VDC
 		vdc.NewVDCsDataSource,
@@ -99,6 +121,10 @@ func (p *cloudavenueProvider) DataSources(_ context.Context) []func() datasource
 		iam.NewUserDataSource,
 		iam.NewRoleDataSource,
 		iam.NewIAMRightDataSource,
+		iam.NewGroupDataSource,
+
+		// CERTIFICATE
+		certificate.NewCertificateDataSource,
 
 		// VM
 		vm.NewVMAffinityRuleDatasource,
@@ -109,6 +135,11 @@ func (p *cloudavenueProvider) DataSources(_ context.Context) []func() datasource
 		network.NewNetworkRoutedDataSource,
 		network.NewDhcpDataSource,
 		network.NewDhcpBindingDataSource,
+		network.NewSegmentProfileTemplatesDataSource,
+		network.NewSegmentProfilesDataSource,
+
+		// DISK
+		disk.NewDisksDataSource,
 
 		// STORAGE
 		storage.NewProfileDataSource,
@@ -121,19 +152,25 @@ func (p *cloudavenueProvider) Resources(_ context.Context) []func() resource.Res
 	return []func() resource.Resource{
 		// ALB
 		alb.NewAlbPoolResource,
+		alb.NewAlbVirtualServiceResource,
 
 		// EDGE GATEWAY
 		edgegw.NewEdgeGatewayResource,
 		edgegw.NewFirewallResource,
+		edgegw.NewFirewallRuleResource,
 		edgegw.NewPortProfilesResource,
 		edgegw.NewSecurityGroupResource,
 		edgegw.NewIPSetResource,
 		edgegw.NewDhcpForwardingResource,
 		edgegw.NewStaticRouteResource,
+		edgegw.NewNATRuleResource,
+		edgegw.NewVPNIPSecResource,
 
 		// VDC
 		vdc.NewVDCResource,
 		vdc.NewACLResource,
+		vdc.NewVDCGroupResource,
+		vdc.NewVDCGroupFirewallResource,
 
 		// VCDA
 		vcda.NewVCDAIPResource,
@@ -149,10 +186,17 @@ func (p *cloudavenueProvider) Resources(_ context.Context) []func() resource.Res
 
 		// CATALOG
 		catalog.NewCatalogResource,
+		catalog.NewVAppTemplateResource,
+		catalog.NewCatalogMediaResource,
+		catalog.NewACLResource,
 
 		// IAM
 		iam.NewIAMUserResource,
 		iam.NewRoleResource,
+		iam.NewGroupResource,
+
+		// CERTIFICATE
+		certificate.NewCertificateResource,
 
 		// VM
 		vm.NewDiskResource,
@@ -160,12 +204,21 @@ func (p *cloudavenueProvider) Resources(_ context.Context) []func() resource.Res
 		vm.NewVMInsertedMediaResource,
 		vm.NewVMAffinityRuleResource,
 		vm.NewSecurityTagResource,
+		vm.NewVMSnapshotResource,
+		vm.NewVMDiskAttachmentResource,
+
+		// DISK
+		disk.NewDiskResource,
 
 		// NETWORK
 		network.NewNetworkRoutedResource,
 		network.NewNetworkIsolatedResource,
 		network.NewDhcpBindingResource,
 		network.NewDhcpResource,
+		network.NewSegmentProfileResource,
+
+		// ORG
+		org.NewOrgSettingsResource,
 	}
 }
 
@@ -215,6 +268,25 @@ func (p *cloudavenueProvider) Schema(
 				MarkdownDescription: "The password to use to connect to the Cloud Avenue API. Can also be set with the `CLOUDAVENUE_PASSWORD` environment variable.",
 				Sensitive:           true,
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_token"), path.MatchRoot("token")),
+				},
+			},
+			"api_token": schema.StringAttribute{
+				MarkdownDescription: "The API token (refresh token) of a user or a service account used to connect to the Cloud Avenue API. Can also be set with the `CLOUDAVENUE_API_TOKEN` environment variable.",
+				Sensitive:           true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password"), path.MatchRoot("token")),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "An already issued bearer token used to connect to the Cloud Avenue API. Can also be set with the `CLOUDAVENUE_TOKEN` environment variable.",
+				Sensitive:           true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password"), path.MatchRoot("api_token")),
+				},
 			},
 			"org": schema.StringAttribute{
 				MarkdownDescription: "The organization used on Cloud Avenue API. Can also be set with the `CLOUDAVENUE_ORG` environment variable.",
@@ -224,6 +296,55 @@ func (p *cloudavenueProvider) Schema(
 				MarkdownDescription: "The VDC used on Cloud Avenue API. Can also be set with the `CLOUDAVENUE_VDC` environment variable.",
 				Optional:            true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of retries of a request failing with a transient error (HTTP 429, busy object or another task running, HTTP 503 with `Retry-After`, and HTTP 500, 502, 503 or 504 on the requests which can be safely sent again). Set to `0` to disable the retries. Can also be set with the `CLOUDAVENUE_MAX_RETRIES` environment variable. Defaults to `5`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "The maximum wait in seconds between two retries. The wait is doubled on each retry, or set by the `Retry-After` header of the response. Can also be set with the `CLOUDAVENUE_RETRY_MAX_WAIT` environment variable. Defaults to `30`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the proxy used to connect to the Cloud Avenue API, e.g. `http://proxy.example.com:3128`. Can also be set with the `CLOUDAVENUE_PROXY_URL` environment variable. Defaults to the proxy set with the `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^(https?|socks5):\/\/\S+$`),
+						"must be a http, https or socks5 URL",
+					),
+				},
+			},
+			"ca_file": schema.StringAttribute{
+				MarkdownDescription: "The path of a PEM CA bundle trusted in addition to the system certificates, e.g. the CA of an inspecting proxy. Can also be set with the `CLOUDAVENUE_CA_FILE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_pem")),
+				},
+			},
+			"ca_pem": schema.StringAttribute{
+				MarkdownDescription: "A PEM CA bundle trusted in addition to the system certificates, e.g. the CA of an inspecting proxy. Can also be set with the `CLOUDAVENUE_CA_PEM` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_file")),
+				},
+			},
+			"insecure": schema.BoolAttribute{
+				MarkdownDescription: "Disable the verification of the TLS certificate of the Cloud Avenue API. Use it only for testing. Can also be set with the `CLOUDAVENUE_INSECURE` environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"http_timeout": schema.Int64Attribute{
+				MarkdownDescription: "The timeout in seconds of a request to the Cloud Avenue API, including the reading of the response. Can also be set with the `CLOUDAVENUE_HTTP_TIMEOUT` environment variable. Defaults to `600`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -247,6 +368,8 @@ func (p *cloudavenueProvider) Configure(
 	urlCloudAvenue := os.Getenv("CLOUDAVENUE_URL")
 	user := os.Getenv("CLOUDAVENUE_USER")
 	password := os.Getenv("CLOUDAVENUE_PASSWORD")
+	apiToken := os.Getenv("CLOUDAVENUE_API_TOKEN")
+	token := os.Getenv("CLOUDAVENUE_TOKEN")
 	org := os.Getenv("CLOUDAVENUE_ORG")
 	vdc := os.Getenv("CLOUDAVENUE_VDC")
 
@@ -259,6 +382,12 @@ func (p *cloudavenueProvider) Configure(
 	if !config.Password.IsNull() && config.Password.ValueString() != "" {
 		password = config.Password.ValueString()
 	}
+	if !config.APIToken.IsNull() && config.APIToken.ValueString() != "" {
+		apiToken = config.APIToken.ValueString()
+	}
+	if !config.Token.IsNull() && config.Token.ValueString() != "" {
+		token = config.Token.ValueString()
+	}
 	if !config.Org.IsNull() && config.Org.ValueString() != "" {
 		org = config.Org.ValueString()
 	}
@@ -269,13 +398,81 @@ func (p *cloudavenueProvider) Configure(
 		vdc = config.VDC.ValueString()
 	}
 
+	maxRetries := int64FromEnv(&resp.Diagnostics, "max_retries", "CLOUDAVENUE_MAX_RETRIES", 0, client.DefaultMaxRetries)
+	retryMaxWait := int64FromEnv(&resp.Diagnostics, "retry_max_wait", "CLOUDAVENUE_RETRY_MAX_WAIT", 1, int64(client.DefaultRetryMaxWait/time.Second))
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	}
+	if !config.RetryMaxWait.IsNull() && !config.RetryMaxWait.IsUnknown() {
+		retryMaxWait = config.RetryMaxWait.ValueInt64()
+	}
+
+	proxyURL := os.Getenv("CLOUDAVENUE_PROXY_URL")
+	caFile := os.Getenv("CLOUDAVENUE_CA_FILE")
+	caPEM := os.Getenv("CLOUDAVENUE_CA_PEM")
+	insecure := boolFromEnv(&resp.Diagnostics, "insecure", "CLOUDAVENUE_INSECURE")
+	httpTimeout := int64FromEnv(&resp.Diagnostics, "http_timeout", "CLOUDAVENUE_HTTP_TIMEOUT", 1, int64(client.DefaultHTTPTimeout/time.Second))
+	if !config.ProxyURL.IsNull() && config.ProxyURL.ValueString() != "" {
+		proxyURL = config.ProxyURL.ValueString()
+	}
+	// The CA bundle set in the configuration takes precedence over the one set with the environment variables.
+	if !config.CAFile.IsNull() && config.CAFile.ValueString() != "" {
+		caFile, caPEM = config.CAFile.ValueString(), ""
+	}
+	if !config.CAPEM.IsNull() && config.CAPEM.ValueString() != "" {
+		caFile, caPEM = "", config.CAPEM.ValueString()
+	}
+	if !config.Insecure.IsNull() && !config.Insecure.IsUnknown() {
+		insecure = config.Insecure.ValueBool()
+	}
+	if !config.HTTPTimeout.IsNull() && !config.HTTPTimeout.IsUnknown() {
+		httpTimeout = config.HTTPTimeout.ValueInt64()
+	}
+	if insecure {
+		tflog.Warn(ctx, "The verification of the TLS certificate of the Cloud Avenue API is disabled")
+	}
+
 	// Default URL to the public Cloud Avenue API if not set.
 	if urlCloudAvenue == "" {
 		urlCloudAvenue = "https://console1.cloudavenue.orange-business.com"
 	}
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
-	if user == "" {
+	// Only one authentication method can be used. The credentials set in the configuration
+	// take precedence over the ones set with the environment variables.
+	switch {
+	case !config.Password.IsNull() && config.Password.ValueString() != "":
+		apiToken, token = "", ""
+	case !config.APIToken.IsNull() && config.APIToken.ValueString() != "":
+		password, token = "", ""
+	case !config.Token.IsNull() && config.Token.ValueString() != "":
+		password, apiToken = "", ""
+	}
+
+	authMethods := 0
+	for _, v := range []string{password, apiToken, token} {
+		if v != "" {
+			authMethods++
+		}
+	}
+
+	switch {
+	case authMethods > 1:
+		resp.Diagnostics.AddError(
+			"Conflicting Cloud Avenue API Credentials",
+			"The provider cannot create the Cloud Avenue API client as more than one authentication method is set. "+
+				"Set only one of the password, api_token or token values in the configuration or with the CLOUDAVENUE_PASSWORD, "+
+				"CLOUDAVENUE_API_TOKEN or CLOUDAVENUE_TOKEN environment variables.",
+		)
+	case authMethods == 0:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing Cloud Avenue API Credentials",
+			"The provider cannot create the Cloud Avenue API client as there is a missing or empty value for the Cloud Avenue API password, api_token or token. "+
+				"Set one of these values in the configuration or use the CLOUDAVENUE_PASSWORD, CLOUDAVENUE_API_TOKEN or CLOUDAVENUE_TOKEN environment variables. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	case password != "" && user == "":
 		resp.Diagnostics.AddAttributeError(
 			path.Root("user"),
 			"Missing Cloud Avenue API User",
@@ -284,15 +481,6 @@ func (p *cloudavenueProvider) Configure(
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
-	if password == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
-			"Missing Cloud Avenue API Password",
-			"The provider cannot create the Cloud Avenue API client as there is a missing or empty value for the Cloud Avenue API password. "+
-				"Set the host value in the configuration or use the CLOUDAVENUE_PASWWORD environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
-	}
 	if org == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("org"),
@@ -311,7 +499,9 @@ func (p *cloudavenueProvider) Configure(
 	ctx = tflog.SetField(ctx, "cloudavenue_username", user)
 	ctx = tflog.SetField(ctx, "cloudavenue_org", org)
 	ctx = tflog.SetField(ctx, "cloudavenue_password", password)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "cloudavenue_password")
+	ctx = tflog.SetField(ctx, "cloudavenue_api_token", apiToken)
+	ctx = tflog.SetField(ctx, "cloudavenue_token", token)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "cloudavenue_password", "cloudavenue_api_token", "cloudavenue_token")
 
 	tflog.Debug(ctx, "Creating CloudAvenue client")
 
@@ -319,11 +509,21 @@ func (p *cloudavenueProvider) Configure(
 		URL:                urlCloudAvenue,
 		User:               user,
 		Password:           password,
+		APIToken:           apiToken,
+		Token:              token,
 		Org:                org,
 		VDC:                vdc,
 		TerraformVersion:   req.TerraformVersion,
 		CloudAvenueVersion: p.version,
 		VCDVersion:         VCDVersion,
+		MaxRetries:         int(maxRetries),
+		RetryMaxWait:       time.Duration(retryMaxWait) * time.Second,
+		LogContext:         ctx,
+		ProxyURL:           proxyURL,
+		CAFile:             caFile,
+		CAPEM:              caPEM,
+		Insecure:           insecure,
+		HTTPTimeout:        time.Duration(httpTimeout) * time.Second,
 	}
 
 	cA, err := cloudAvenue.New()
@@ -331,18 +531,26 @@ func (p *cloudavenueProvider) Configure(
 		switch {
 		case errors.Is(err, client.ErrAuthFailed):
 			resp.Diagnostics.AddError(
-				"Unable to Create Cloud Avenue API Client",
-				"An unexpected error occurred when creating the Cloud Avenue API client. "+
-					"If the error is not clear, please contact the provider developers.\n\n"+
+				"Unable to Authenticate to Cloud Avenue API",
+				"The authentication with the "+cloudAvenue.AuthMethod()+" failed. "+
+					"Ensure the credentials are valid and not expired.\n\n"+
 					"Cloud Avenue Client Error: "+err.Error(),
 			)
 			return
 		case errors.Is(err, client.ErrTokenEmpty):
 			resp.Diagnostics.AddError(
-				"Unable to Create Cloud Avenue API Client",
-				"An unexpected error occurred when creating the Cloud Avenue API client. "+
+				"Unable to Authenticate to Cloud Avenue API",
+				"The Cloud Avenue API did not return an access token for the "+cloudAvenue.AuthMethod()+". "+
 					"If the error is not clear, please contact the provider developers.\n\n"+
-					"Cloud Avenue Client Error: empty token",
+					"Cloud Avenue Client Error: "+err.Error(),
+			)
+			return
+		case errors.Is(err, client.ErrConfigureTransport):
+			resp.Diagnostics.AddError(
+				"Unable to Configure Cloud Avenue HTTP Client",
+				"The HTTP client cannot be created with the proxy_url, ca_file, ca_pem or insecure settings. "+
+					"Ensure the proxy URL is valid and the CA bundle contains PEM certificates.\n\n"+
+					"Cloud Avenue Client Error: "+err.Error(),
 			)
 			return
 		case errors.Is(err, client.ErrConfigureVmware):
@@ -379,3 +587,44 @@ func (p *cloudavenueProvider) Configure(
 
 	tflog.Info(ctx, "Configured Cloud Avenue client", map[string]any{"success": true})
 }
+
+// int64FromEnv returns the value of the environment variable key, or defaultValue if it is not set.
+// An error is added to diags if the value is not an integer greater than or equal to minValue.
+func int64FromEnv(diags *diag.Diagnostics, attribute, key string, minValue, defaultValue int64) int64 {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultValue
+	}
+
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < minValue {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Cloud Avenue Provider Setting",
+			fmt.Sprintf("The %s environment variable must be an integer greater than or equal to %d, got %q.", key, minValue, v),
+		)
+		return defaultValue
+	}
+
+	return n
+}
+
+// boolFromEnv returns the value of the environment variable key, or false if it is not set.
+// An error is added to diags if the value is not a boolean.
+func boolFromEnv(diags *diag.Diagnostics, attribute, key string) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return false
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Cloud Avenue Provider Setting",
+			fmt.Sprintf("The %s environment variable must be a boolean, got %q.", key, v),
+		)
+	}
+
+	return b
+}
@@ -3,6 +3,7 @@ package publicip
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -27,6 +28,11 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
 )
 
+// privateStateKeyKnownPublicIPs is the key of the private state storing the Public IPs existing before the creation.
+const privateStateKeyKnownPublicIPs = "known_public_ips"
+
+var errPublicIPNotFound = errors.New("no public ip found")
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &publicIPResource{}
@@ -85,10 +91,7 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 	// Retrieve values from plan
 	plan := &publicIPResourceModel{}
 
-	var (
-		edgeGateway            edgegw.EdgeGateway
-		findIPNotAlreadyExists func(IPs apiclient.PublicIps) (interface{}, error)
-	)
+	var edgeGateway edgegw.EdgeGateway
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -123,9 +126,6 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	cloudavenue.Lock(ctx)
-	defer cloudavenue.Unlock(ctx)
-
 	edgeGateway, err := r.adminOrg.GetEdgeGateway(edgegw.BaseEdgeGW{
 		Name: plan.EdgeGatewayName,
 		ID:   plan.EdgeGatewayID,
@@ -135,6 +135,12 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	if errLock := cloudavenue.Lock(ctxTO, cloudavenue.KeyOrg); errLock != nil {
+		resp.Diagnostics.AddError("Error locking the Cloud Avenue API", errLock.Error())
+		return
+	}
+	defer cloudavenue.Unlock(ctx, cloudavenue.KeyOrg)
+
 	body := apiclient.PublicIPApiCreatePublicIPOpts{
 		XNattedIP:    optional.EmptyString(),
 		XVDCEdgeName: optional.NewString(edgeGateway.GetName()),
@@ -144,9 +150,8 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new Public IP
 	// Set vars
 	var (
-		job     apiclient.Jobcreated
-		httpR   *http.Response
-		knowIPs []apiclient.PublicIpsNetworkConfig
+		job   apiclient.Jobcreated
+		httpR *http.Response
 	)
 
 	// Store existing Public IP
@@ -163,31 +168,11 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	knowIPs = append(knowIPs, publicIPs.NetworkConfig...)
-
-	// Find an ip that is not already existing in the vdc
-	// this function var is called later in the code...
-	findIPNotAlreadyExists = func(IPs apiclient.PublicIps) (interface{}, error) {
-		if len(IPs.NetworkConfig) == 0 {
-			return nil, fmt.Errorf("no public ip found")
-		}
-
-		// knowIPs is a list of ips that are already existing in the vdc
-		// we need to find an ip that is not in this list
-		// compare the list of public ip after the creation of public ip to the list of public ip before the creation of new public ip
-		for _, IP := range IPs.NetworkConfig {
-			for j, knownIP := range knowIPs {
-				if knownIP.UplinkIp == IP.UplinkIp {
-					// if ip is equal then go to next ip to compare
-					break
-				}
-				// if ip is not found on list of public ip before the creation then we found the new one
-				if j == (len(knowIPs) - 1) {
-					return IP, nil
-				}
-			}
-		}
-		return apiclient.PublicIpsNetworkConfig{}, fmt.Errorf("no public ip found")
+	// knowIPs is a list of ips that are already existing in the vdc
+	// the new public ip is the one that is not in this list after the creation
+	knowIPs := make([]string, 0, len(publicIPs.NetworkConfig))
+	for _, IP := range publicIPs.NetworkConfig {
+		knowIPs = append(knowIPs, IP.UplinkIp)
 	}
 
 	job, httpR, err = r.client.APIClient.PublicIPApi.CreatePublicIP(auth, &body)
@@ -203,57 +188,35 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Wait for job to complete
-	errRetry := retry.RetryContext(ctxTO, createTimeout, func() *retry.RetryError {
-		jobStatus, errGetJob := helpers.GetJobStatus(auth, r.client, job.JobId)
-		if errGetJob != nil {
-			retry.NonRetryableError(err)
+	if errJob := helpers.WaitForJob(auth, r.client, job.JobId, createTimeout); errJob != nil {
+		if errors.Is(errJob, helpers.ErrJobStillRunning) {
+			// The Public IP is still being created, keep track of the job and of the existing
+			// Public IPs to find the new one on the next refresh.
+			plan.ID = types.StringNull()
+			plan.PublicIP = types.StringNull()
+			plan.EdgeGatewayID = types.StringValue(edgeGateway.GetID())
+			plan.EdgeGatewayName = types.StringValue(edgeGateway.GetName())
+			resp.Diagnostics.Append(setKnownPublicIPs(ctx, resp.Private, knowIPs)...)
+			resp.Diagnostics.Append(helpers.SetPendingJob(ctx, resp.Private, job.JobId)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.Append(helpers.NewJobStillRunningWarning("Public IP", job.JobId))
+			return
 		}
-		if !slices.Contains(helpers.JobStateDone(), jobStatus.String()) {
-			return retry.RetryableError(fmt.Errorf("expected job done but was %s", jobStatus))
-		}
-
-		return nil
-	})
-
-	if errRetry != nil {
-		resp.Diagnostics.AddError("Error waiting job to complete", errRetry.Error())
+		resp.Diagnostics.AddError("Error waiting job to complete", errJob.Error())
 		return
 	}
 
 	// get all Public IPs and find the new one
-	checkPublicIPs, httpRc, errGet := r.client.APIClient.PublicIPApi.GetPublicIPs(auth)
-	if httpRc != nil {
-		defer func() {
-			err = errors.Join(err, httpRc.Body.Close())
-		}()
-	}
-
-	if apiErr := helpers.CheckAPIError(errGet, httpRc); apiErr != nil {
-		resp.Diagnostics.Append(apiErr.GetTerraformDiagnostic())
-		return
-	}
-
-	pubIP, errFind := findIPNotAlreadyExists(checkPublicIPs)
+	publicIP, errFind := r.findCreatedPublicIP(auth, edgeGateway.GetName(), knowIPs)
 	if errFind != nil {
 		resp.Diagnostics.AddError("Error finding Public IP", errFind.Error())
 		return
 	}
 
-	var publicIP apiclient.PublicIps
-
-	publicIP.NetworkConfig = append(publicIP.NetworkConfig, pubIP.(apiclient.PublicIpsNetworkConfig))
-	if len(publicIP.NetworkConfig) == 0 {
-		resp.Diagnostics.AddError(
-			"Error creating Public IP",
-			"Could not create Public IP, unexpected error: no public IP found after creation",
-		)
-		return
-	}
-
-	plan.ID = types.StringValue(publicIP.NetworkConfig[0].UplinkIp)
+	plan.ID = types.StringValue(publicIP.UplinkIp)
 	plan.EdgeGatewayID = types.StringValue(edgeGateway.GetID())
 	plan.EdgeGatewayName = types.StringValue(edgeGateway.GetName())
-	plan.PublicIP = types.StringValue(publicIP.NetworkConfig[0].UplinkIp)
+	plan.PublicIP = types.StringValue(publicIP.UplinkIp)
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
@@ -295,6 +258,40 @@ func (r *publicIPResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	// Resume the creation job interrupted during a previous apply
+	jobStatus, d := helpers.ResumePendingJob(auth, r.client, resp.Private, readTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || jobStatus == helpers.INPROGRESS {
+		return
+	}
+	if jobStatus == helpers.FAILED {
+		// The Public IP has not been created, remove it from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.ID.IsNull() {
+		// The creation has been interrupted, retrieve the Public IP created by the job
+		knowIPs, d := getKnownPublicIPs(ctx, resp.Private)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		publicIP, err := r.findCreatedPublicIP(auth, state.EdgeGatewayName.ValueString(), knowIPs)
+		if err != nil {
+			if errors.Is(err, errPublicIPNotFound) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("Error finding Public IP", err.Error())
+			return
+		}
+		state.ID = types.StringValue(publicIP.UplinkIp)
+
+		resp.Diagnostics.Append(setKnownPublicIPs(ctx, resp.Private, nil)...)
+	}
+
 	// Get Public IP
 	publicIPs, httpR, err := r.client.APIClient.PublicIPApi.GetPublicIPs(auth)
 
@@ -385,8 +382,44 @@ func (r *publicIPResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	cloudavenue.Lock(ctx)
-	defer cloudavenue.Unlock(ctx)
+	if errLock := cloudavenue.Lock(ctxTO, cloudavenue.KeyEdgeGateway(state.EdgeGatewayName.ValueString())); errLock != nil {
+		resp.Diagnostics.AddError("Error locking the Cloud Avenue API", errLock.Error())
+		return
+	}
+	defer cloudavenue.Unlock(ctx, cloudavenue.KeyEdgeGateway(state.EdgeGatewayName.ValueString()))
+
+	// Wait for the creation job interrupted during a previous apply
+	jobStatus, d := helpers.ResumePendingJob(auth, r.client, req.Private, deleteTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	switch jobStatus {
+	case helpers.FAILED:
+		// The Public IP has not been created, nothing to delete
+		return
+	case helpers.INPROGRESS:
+		resp.Diagnostics.AddError("Error deleting Public IP", "The Public IP is still being created, it cannot be deleted yet.")
+		return
+	}
+
+	if state.PublicIP.IsNull() {
+		// The state has not been refreshed since the creation has been interrupted
+		knowIPs, d := getKnownPublicIPs(ctx, req.Private)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		publicIP, err := r.findCreatedPublicIP(auth, state.EdgeGatewayName.ValueString(), knowIPs)
+		if err != nil {
+			if !errors.Is(err, errPublicIPNotFound) {
+				resp.Diagnostics.AddError("Error finding Public IP", err.Error())
+			}
+			return
+		}
+		state.PublicIP = types.StringValue(publicIP.UplinkIp)
+	}
 
 	// Delete the public IP
 	job, httpR, err := r.client.APIClient.PublicIPApi.DeletePublicIP(auth, state.PublicIP.ValueString())
@@ -423,3 +456,50 @@ func (r *publicIPResource) ImportState(ctx context.Context, req resource.ImportS
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// findCreatedPublicIP returns the Public IP of the edge gateway which is not in the list of the Public IPs existing before the creation.
+// The Public IPs created outside of Terraform on other edge gateways are ignored.
+func (r *publicIPResource) findCreatedPublicIP(ctx context.Context, edgeGatewayName string, knowIPs []string) (publicIP apiclient.PublicIpsNetworkConfig, err error) {
+	publicIPs, httpR, err := r.client.APIClient.PublicIPApi.GetPublicIPs(ctx)
+	if httpR != nil {
+		defer func() {
+			err = errors.Join(err, httpR.Body.Close())
+		}()
+	}
+	if apiErr := helpers.CheckAPIError(err, httpR); apiErr != nil {
+		return publicIP, apiErr
+	}
+
+	for _, IP := range publicIPs.NetworkConfig {
+		if IP.EdgeGatewayName == edgeGatewayName && !slices.Contains(knowIPs, IP.UplinkIp) {
+			return IP, nil
+		}
+	}
+
+	return publicIP, errPublicIPNotFound
+}
+
+// setKnownPublicIPs stores in the private state the Public IPs existing before the creation.
+func setKnownPublicIPs(ctx context.Context, private helpers.PrivateState, knowIPs []string) (diags diag.Diagnostics) {
+	value, err := json.Marshal(knowIPs)
+	if err != nil {
+		diags.AddError("Error storing the Public IPs in the private state", err.Error())
+		return
+	}
+
+	return private.SetKey(ctx, privateStateKeyKnownPublicIPs, value)
+}
+
+// getKnownPublicIPs returns the Public IPs existing before the creation stored in the private state.
+func getKnownPublicIPs(ctx context.Context, private helpers.PrivateState) (knowIPs []string, diags diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, privateStateKeyKnownPublicIPs)
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	if err := json.Unmarshal(value, &knowIPs); err != nil {
+		diags.AddError("Error reading the Public IPs from the private state", err.Error())
+	}
+
+	return knowIPs, diags
+}
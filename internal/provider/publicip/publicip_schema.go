@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers"
 )
 
 func publicIPSchema() superschema.Schema {
@@ -21,7 +23,7 @@ func publicIPSchema() superschema.Schema {
 			MarkdownDescription: "This allows you to",
 		},
 		Resource: superschema.SchemaDetails{
-			MarkdownDescription: "manage a Public IP in Cloud Avenue.",
+			MarkdownDescription: "manage a Public IP in Cloud Avenue.\n\n" + helpers.PendingJobNote,
 		},
 		DataSource: superschema.SchemaDetails{
 			MarkdownDescription: "retrieve information about a Public IP in Cloud Avenue.",
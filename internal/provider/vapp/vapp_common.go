@@ -15,6 +15,7 @@ type vappResourceModel struct {
 	Description     types.String `tfsdk:"description"`
 	GuestProperties types.Map    `tfsdk:"guest_properties"`
 	Lease           types.Object `tfsdk:"lease"`
+	Metadata        types.Set    `tfsdk:"metadata"`
 }
 
 func processGuestProperties(vapp vapp.VAPP) (properties map[string]attr.Value, d diag.Diagnostics) {
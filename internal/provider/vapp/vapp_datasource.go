@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 )
@@ -105,6 +106,12 @@ func (d *vappDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	data.Metadata, diags = metadata.Get(ctx, d.vapp)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
 	leaseInfo, err := d.vapp.GetLease()
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to get lease info", err.Error())
@@ -24,6 +24,7 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
@@ -137,6 +138,7 @@ func (r *vappResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Update vApp
 	state := &vappResourceModel{
 		Description: types.StringValue(r.vapp.GetDescription()),
+		Metadata:    types.SetNull(types.ObjectType{AttrTypes: metadata.AttrTypes}),
 	}
 	resp.Diagnostics.Append(r.updateVapp(ctx, plan, state)...)
 	if resp.Diagnostics.HasError() {
@@ -146,6 +148,12 @@ func (r *vappResource) Create(ctx context.Context, req resource.CreateRequest, r
 	plan.VAppID = types.StringValue(r.vapp.GetID())
 	plan.VDC = types.StringValue(r.vdc.GetName())
 
+	plan.Metadata, diags = metadata.Get(ctx, r.vapp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -211,6 +219,13 @@ func (r *vappResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// Get metadata
+	plan.Metadata, diags = metadata.Get(ctx, r.vapp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if leaseInfo != nil {
 		plan.Lease, diags = types.ObjectValueFrom(ctx, vappLeaseAttrTypes, vappLeaseModel{
 			RuntimeLeaseInSec: types.Int64Value(int64(leaseInfo.DeploymentLeaseInSeconds)),
@@ -259,6 +274,12 @@ func (r *vappResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	plan.Metadata, diags = metadata.Get(ctx, r.vapp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -422,5 +443,11 @@ func (r *vappResource) updateVapp(ctx context.Context, plan, state *vappResource
 		}
 	}
 
+	// Update metadata if needed
+	d.Append(metadata.Update(ctx, r.vapp, plan.Metadata, state.Metadata)...)
+	if d.HasError() {
+		return
+	}
+
 	return nil
 }
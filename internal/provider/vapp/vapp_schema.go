@@ -19,6 +19,7 @@ import (
 
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
 
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 )
 
@@ -98,6 +99,7 @@ func vappSchema() superschema.Schema {
 					Computed: true,
 				},
 			},
+			metadata.SchemaName: metadata.SuperSchema(),
 			"lease": superschema.SingleNestedAttribute{
 				Common: &schemaR.SingleNestedAttribute{
 					MarkdownDescription: "Informations about vApp lease",
@@ -80,8 +80,11 @@ func (r *vcdaIPResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	cloudavenue.Lock(ctx)
-	defer cloudavenue.Unlock(ctx)
+	if errLock := cloudavenue.Lock(ctx, cloudavenue.KeyOrg); errLock != nil {
+		resp.Diagnostics.AddError("Error locking the Cloud Avenue API", errLock.Error())
+		return
+	}
+	defer cloudavenue.Unlock(ctx, cloudavenue.KeyOrg)
 
 	// Call API to create the resource and check for errors.
 	_, httpR, err := r.client.APIClient.VCDAApi.CreateVcdaIP(r.client.Auth, plan.IPAddress.ValueString())
@@ -157,8 +160,11 @@ func (r *vcdaIPResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	cloudavenue.Lock(ctx)
-	defer cloudavenue.Unlock(ctx)
+	if errLock := cloudavenue.Lock(ctx, cloudavenue.KeyOrg); errLock != nil {
+		resp.Diagnostics.AddError("Error locking the Cloud Avenue API", errLock.Error())
+		return
+	}
+	defer cloudavenue.Unlock(ctx, cloudavenue.KeyOrg)
 
 	// Call API to delete the resource and check for errors.
 	_, httpR, err := r.client.APIClient.VCDAApi.DeleteVcdaIP(r.client.Auth, state.IPAddress.ValueString())
@@ -12,6 +12,7 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
@@ -113,6 +114,19 @@ func (d *vdcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		profiles = append(profiles, p)
 	}
 
+	// Get metadata
+	adminVDC, err := d.client.GetAdminVDC(client.WithAdminVDCName(data.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get AdminVDC", err.Error())
+		return
+	}
+
+	md, diags := metadata.Get(ctx, adminVDC)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data = vdcDataSourceModel{
 		ID:                     types.StringValue(ID),
 		VDCGroup:               types.StringValue(vdc.VdcGroup),
@@ -126,6 +140,7 @@ func (d *vdcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		MemoryAllocated:        types.Float64Value(vdc.Vdc.MemoryAllocated),
 		VDCStorageBillingModel: types.StringValue(vdc.Vdc.VdcStorageBillingModel),
 		VDCStorageProfiles:     profiles,
+		Metadata:               md,
 	}
 
 	// Save data into Terraform state
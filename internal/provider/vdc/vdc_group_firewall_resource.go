@@ -0,0 +1,357 @@
+// Package vdc provides a Terraform resource.
+package vdc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &vdcGroupFirewallResource{}
+	_ resource.ResourceWithConfigure   = &vdcGroupFirewallResource{}
+	_ resource.ResourceWithImportState = &vdcGroupFirewallResource{}
+)
+
+// NewVDCGroupFirewallResource is a helper function to simplify the provider implementation.
+func NewVDCGroupFirewallResource() resource.Resource {
+	return &vdcGroupFirewallResource{}
+}
+
+// vdcGroupFirewallResource is the resource implementation.
+type vdcGroupFirewallResource struct {
+	client   *client.CloudAvenue
+	vdcGroup *client.VDCGroup
+}
+
+// Init Initializes the resource.
+func (r *vdcGroupFirewallResource) Init(_ context.Context, rm *vdcGroupFirewallModel) (diags diag.Diagnostics) {
+	if err := r.getVDCGroup(rm); err != nil {
+		diags.AddError("Error retrieving vDC Group", err.Error())
+	}
+
+	return
+}
+
+// getVDCGroup retrieves the vDC Group of the resource.
+func (r *vdcGroupFirewallResource) getVDCGroup(rm *vdcGroupFirewallModel) (err error) {
+	vdcGroupNameOrID := rm.VDCGroupID.ValueString()
+	if vdcGroupNameOrID == "" {
+		vdcGroupNameOrID = rm.VDCGroupName.ValueString()
+	}
+
+	r.vdcGroup, err = r.client.GetVDCGroupByNameOrID(vdcGroupNameOrID)
+	return err
+}
+
+// Metadata returns the resource type name.
+func (r *vdcGroupFirewallResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_group_firewall"
+}
+
+// Schema defines the schema for the resource.
+func (r *vdcGroupFirewallResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = vdcGroupFirewallSchema().GetResource(ctx)
+}
+
+func (r *vdcGroupFirewallResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *vdcGroupFirewallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &vdcGroupFirewallModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, d := r.createOrUpdate(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *vdcGroupFirewallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &vdcGroupFirewallModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	if err := r.getVDCGroup(state); err != nil {
+		if govcd.ContainsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving vDC Group", err.Error())
+		return
+	}
+
+	stateRefreshed, d := r.read(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *vdcGroupFirewallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := &vdcGroupFirewallModel{}
+
+	// Get current plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, d := r.createOrUpdate(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *vdcGroupFirewallResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &vdcGroupFirewallModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	if err := r.getVDCGroup(state); err != nil {
+		if govcd.ContainsNotFound(err) {
+			// The vDC Group is already deleted.
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving vDC Group", err.Error())
+		return
+	}
+
+	if errLock := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
+
+	if err := r.vdcGroup.DeleteAllDistributedFirewallRules(); err != nil {
+		resp.Diagnostics.AddError("Error deleting vDC Group distributed firewall rules", err.Error())
+	}
+}
+
+func (r *vdcGroupFirewallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vdcGroup, err := r.client.GetVDCGroupByNameOrID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing vDC Group firewall", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vdcGroup.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc_group_id"), vdcGroup.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc_group_name"), vdcGroup.GetName())...)
+}
+
+// createOrUpdate replaces all the rules of the distributed firewall with the rules of the plan.
+func (r *vdcGroupFirewallResource) createOrUpdate(ctx context.Context, plan *vdcGroupFirewallModel) (state *vdcGroupFirewallModel, diags diag.Diagnostics) {
+	rules := make([]vdcGroupFirewallModelRule, 0)
+	diags.Append(plan.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	if errLock := mutex.GlobalMutex.KvLock(ctx, r.vdcGroup.GetID()); errLock != nil {
+		diags.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer mutex.GlobalMutex.KvUnlock(ctx, r.vdcGroup.GetID())
+
+	dfwRules, d := r.rulesToDistributedFirewallRules(ctx, rules)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	if _, err := r.vdcGroup.UpdateDistributedFirewall(&govcdtypes.DistributedFirewallRules{
+		Values: dfwRules,
+	}); err != nil {
+		diags.AddError("Error updating vDC Group distributed firewall rules", err.Error())
+		return
+	}
+
+	return r.read(ctx)
+}
+
+// rulesToDistributedFirewallRules converts the rules of the plan to distributed firewall rules.
+// The firewall groups must belong to the vDC Group.
+func (r *vdcGroupFirewallResource) rulesToDistributedFirewallRules(ctx context.Context, rules []vdcGroupFirewallModelRule) (dfwRules []*govcdtypes.DistributedFirewallRule, diags diag.Diagnostics) {
+	dfwRules = make([]*govcdtypes.DistributedFirewallRule, len(rules))
+
+	for i, rule := range rules {
+		dfwRules[i] = &govcdtypes.DistributedFirewallRule{
+			Name:       rule.Name.ValueString(),
+			Action:     rule.Action.ValueString(),
+			Enabled:    rule.Enabled.ValueBool(),
+			IpProtocol: rule.IPProtocol.ValueString(),
+			Logging:    rule.Logging.ValueBool(),
+			Direction:  rule.Direction.ValueString(),
+		}
+
+		// ! If sourceIDs/destinationIDs is Null, it's an equivalent of any (source/destination)
+		sourceIDs := make([]string, 0)
+		diags.Append(rule.SourceIDs.ElementsAs(ctx, &sourceIDs, false)...)
+		destinationIDs := make([]string, 0)
+		diags.Append(rule.DestinationIDs.ElementsAs(ctx, &destinationIDs, false)...)
+		appPortProfileIDs := make([]string, 0)
+		diags.Append(rule.AppPortProfileIDs.ElementsAs(ctx, &appPortProfileIDs, false)...)
+		if diags.HasError() {
+			return
+		}
+
+		for _, id := range append(sourceIDs, destinationIDs...) {
+			// Security groups and IP sets share the same lookup by ID.
+			if _, err := r.vdcGroup.GetSecurityGroupByID(id); err != nil {
+				diags.AddError("Error retrieving firewall group", fmt.Sprintf("firewall group %s of rule %s not found in vDC Group %s: %s", id, rule.Name.ValueString(), r.vdcGroup.GetName(), err))
+				return
+			}
+		}
+
+		dfwRules[i].SourceFirewallGroups = idsToOpenAPIReferences(sourceIDs)
+		dfwRules[i].DestinationFirewallGroups = idsToOpenAPIReferences(destinationIDs)
+		dfwRules[i].ApplicationPortProfiles = idsToOpenAPIReferences(appPortProfileIDs)
+	}
+
+	return
+}
+
+func (r *vdcGroupFirewallResource) read(ctx context.Context) (state *vdcGroupFirewallModel, diags diag.Diagnostics) {
+	dfw, err := r.vdcGroup.GetDistributedFirewall()
+	if err != nil {
+		diags.AddError("Error retrieving vDC Group distributed firewall", err.Error())
+		return
+	}
+
+	rules := make([]vdcGroupFirewallModelRule, 0, len(dfw.DistributedFirewallRuleContainer.Values))
+	for _, rule := range dfw.DistributedFirewallRuleContainer.Values {
+		sourceIDs, d := openAPIReferencesToSet(ctx, rule.SourceFirewallGroups)
+		diags.Append(d...)
+		destinationIDs, d := openAPIReferencesToSet(ctx, rule.DestinationFirewallGroups)
+		diags.Append(d...)
+		appPortProfileIDs, d := openAPIReferencesToSet(ctx, rule.ApplicationPortProfiles)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+
+		rules = append(rules, vdcGroupFirewallModelRule{
+			ID:                types.StringValue(rule.ID),
+			Name:              types.StringValue(rule.Name),
+			Enabled:           types.BoolValue(rule.Enabled),
+			Direction:         types.StringValue(rule.Direction),
+			IPProtocol:        types.StringValue(rule.IpProtocol),
+			Action:            types.StringValue(rule.Action),
+			Logging:           types.BoolValue(rule.Logging),
+			SourceIDs:         sourceIDs,
+			DestinationIDs:    destinationIDs,
+			AppPortProfileIDs: appPortProfileIDs,
+		})
+	}
+
+	state = &vdcGroupFirewallModel{
+		// ID is stored as vDC Group ID - because this is a "container" for all firewall rules at once.
+		ID:           types.StringValue(r.vdcGroup.GetID()),
+		VDCGroupID:   types.StringValue(r.vdcGroup.GetID()),
+		VDCGroupName: types.StringValue(r.vdcGroup.GetName()),
+	}
+
+	var d diag.Diagnostics
+	state.Rules, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: vdcGroupFirewallModelRuleAttrTypes}, rules)
+	diags.Append(d...)
+
+	return state, diags
+}
+
+// idsToOpenAPIReferences converts a list of IDs to OpenAPI references. A nil slice is returned if the list is empty.
+func idsToOpenAPIReferences(ids []string) []govcdtypes.OpenApiReference {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	refs := make([]govcdtypes.OpenApiReference, len(ids))
+	for i, id := range ids {
+		refs[i] = govcdtypes.OpenApiReference{ID: id}
+	}
+
+	return refs
+}
+
+// openAPIReferencesToSet converts OpenAPI references to a set of IDs. A null set is returned if there is no reference.
+func openAPIReferencesToSet(ctx context.Context, refs []govcdtypes.OpenApiReference) (types.Set, diag.Diagnostics) {
+	if len(refs) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		ids[i] = ref.ID
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, ids)
+}
@@ -0,0 +1,161 @@
+package vdc
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+)
+
+/*
+vdcGroupFirewallSchema
+
+This function is used to create the superschema for the vdc group firewall resource.
+*/
+func vdcGroupFirewallSchema() superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The vDC group firewall resource allows you to manage the rules of the distributed firewall of a vDC group. " +
+				"The distributed firewall must be enabled on the vDC group (see `dfw_enabled` in the `cloudavenue_vdc_group` resource).",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the distributed firewall. It is the ID of the vDC group.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"vdc_group_id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the vDC group.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("vdc_group_name"), path.MatchRoot("vdc_group_id")),
+					},
+				},
+			},
+			"vdc_group_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the vDC group.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("vdc_group_name"), path.MatchRoot("vdc_group_id")),
+					},
+				},
+			},
+			"rules": superschema.ListNestedAttribute{
+				Resource: &schemaR.ListNestedAttribute{
+					MarkdownDescription: "The ordered list of rules to apply to the distributed firewall.",
+					Required:            true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+				Attributes: superschema.Attributes{
+					"id": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The ID of the rule.",
+							Computed:            true,
+						},
+					},
+					"name": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The name of the rule.",
+							Required:            true,
+						},
+					},
+					"direction": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The direction of the rule.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("IN", "OUT", "IN_OUT"),
+							},
+						},
+					},
+					"ip_protocol": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The IP protocol of the rule.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("IPV4"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("IPV4", "IPV6", "IPV4_IPV6"),
+							},
+						},
+					},
+					"action": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "Defines if the rule should `ALLOW`, `DROP` or `REJECT` matching traffic.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("ALLOW", "DROP", "REJECT"),
+							},
+						},
+					},
+					"enabled": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Defines if the rule is enabled or not.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+					},
+					"logging": superschema.BoolAttribute{
+						Resource: &schemaR.BoolAttribute{
+							MarkdownDescription: "Defines if the rule should log matching traffic.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+					"source_ids": superschema.SetAttribute{
+						Resource: &schemaR.SetAttribute{
+							MarkdownDescription: "A set of Source Firewall Group IDs (`IP Sets` or `Security Groups`) of the vDC group. Leaving it empty means `Any` (all).",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+					"destination_ids": superschema.SetAttribute{
+						Resource: &schemaR.SetAttribute{
+							MarkdownDescription: "A set of Destination Firewall Group IDs (`IP Sets` or `Security Groups`) of the vDC group. Leaving it empty means `Any` (all).",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+					"app_port_profile_ids": superschema.SetAttribute{
+						Resource: &schemaR.SetAttribute{
+							MarkdownDescription: "A set of Application Port Profile IDs. Leaving it empty means `Any` (all).",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package vdc
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vdcGroupFirewallModel struct {
+	ID           types.String `tfsdk:"id"`
+	VDCGroupID   types.String `tfsdk:"vdc_group_id"`
+	VDCGroupName types.String `tfsdk:"vdc_group_name"`
+	Rules        types.List   `tfsdk:"rules"`
+}
+
+type vdcGroupFirewallModelRule struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	Direction         types.String `tfsdk:"direction"`
+	IPProtocol        types.String `tfsdk:"ip_protocol"`
+	Action            types.String `tfsdk:"action"`
+	Logging           types.Bool   `tfsdk:"logging"`
+	SourceIDs         types.Set    `tfsdk:"source_ids"`
+	DestinationIDs    types.Set    `tfsdk:"destination_ids"`
+	AppPortProfileIDs types.Set    `tfsdk:"app_port_profile_ids"`
+}
+
+var vdcGroupFirewallModelRuleAttrTypes = map[string]attr.Type{
+	"id":                   types.StringType,
+	"name":                 types.StringType,
+	"enabled":              types.BoolType,
+	"direction":            types.StringType,
+	"ip_protocol":          types.StringType,
+	"action":               types.StringType,
+	"logging":              types.BoolType,
+	"source_ids":           types.SetType{ElemType: types.StringType},
+	"destination_ids":      types.SetType{ElemType: types.StringType},
+	"app_port_profile_ids": types.SetType{ElemType: types.StringType},
+}
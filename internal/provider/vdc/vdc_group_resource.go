@@ -0,0 +1,281 @@
+// Package vdc provides a Terraform resource.
+package vdc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &vdcGroupResource{}
+	_ resource.ResourceWithConfigure   = &vdcGroupResource{}
+	_ resource.ResourceWithImportState = &vdcGroupResource{}
+)
+
+// NewVDCGroupResource is a helper function to simplify the provider implementation.
+func NewVDCGroupResource() resource.Resource {
+	return &vdcGroupResource{}
+}
+
+// vdcGroupResource is the resource implementation.
+type vdcGroupResource struct {
+	client *client.CloudAvenue
+}
+
+// Metadata returns the resource type name.
+func (r *vdcGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *vdcGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = vdcGroupSchema().GetResource(ctx)
+}
+
+func (r *vdcGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *vdcGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &vdcGroupResourceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vdcIDs := make([]string, 0)
+	resp.Diagnostics.Append(plan.VDCIDs.ElementsAs(ctx, &vdcIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vdcGroup, err := r.client.CreateVDCGroup(plan.Name.ValueString(), plan.Description.ValueString(), plan.Type.ValueString(), vdcIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating vDC Group", err.Error())
+		return
+	}
+
+	if plan.DFWEnabled.ValueBool() {
+		if _, err := vdcGroup.ActivateDfw(); err != nil {
+			// The vDC Group is created, it is saved in the state to be able to delete it.
+			resp.Diagnostics.AddError("Error enabling the distributed firewall", err.Error())
+		}
+	}
+
+	state, found, d := r.read(ctx, vdcGroup.GetID())
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error retrieving vDC Group", "vDC Group not found after creation")
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *vdcGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &vdcGroupResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRefreshed, found, d := r.read(ctx, state.ID.ValueString())
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *vdcGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := &vdcGroupResourceModel{}
+	state := &vdcGroupResourceModel{}
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if errLock := mutex.GlobalMutex.KvLock(ctx, state.ID.ValueString()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer mutex.GlobalMutex.KvUnlock(ctx, state.ID.ValueString())
+
+	vdcGroup, err := r.client.GetVDCGroupByNameOrID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving vDC Group", err.Error())
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) || !plan.VDCIDs.Equal(state.VDCIDs) {
+		vdcIDs := make([]string, 0)
+		resp.Diagnostics.Append(plan.VDCIDs.ElementsAs(ctx, &vdcIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if _, err := vdcGroup.Update(plan.Name.ValueString(), plan.Description.ValueString(), vdcIDs); err != nil {
+			resp.Diagnostics.AddError("Error updating vDC Group", err.Error())
+			return
+		}
+	}
+
+	if !plan.DFWEnabled.Equal(state.DFWEnabled) {
+		if plan.DFWEnabled.ValueBool() {
+			_, err = vdcGroup.ActivateDfw()
+		} else {
+			_, err = vdcGroup.DeactivateDfw()
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating the distributed firewall status", err.Error())
+			return
+		}
+	}
+
+	stateRefreshed, found, d := r.read(ctx, state.ID.ValueString())
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error retrieving vDC Group", "vDC Group not found after update")
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *vdcGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &vdcGroupResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if errLock := mutex.GlobalMutex.KvLock(ctx, state.ID.ValueString()); errLock != nil {
+		resp.Diagnostics.AddError("Error locking resource", errLock.Error())
+		return
+	}
+	defer mutex.GlobalMutex.KvUnlock(ctx, state.ID.ValueString())
+
+	vdcGroup, err := r.client.GetVDCGroupByNameOrID(state.ID.ValueString())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving vDC Group", err.Error())
+		return
+	}
+
+	// The distributed firewall must be disabled before deleting the vDC Group.
+	if vdcGroup.VdcGroup.VdcGroup.DfwEnabled {
+		if _, err := vdcGroup.DeactivateDfw(); err != nil {
+			resp.Diagnostics.AddError("Error disabling the distributed firewall", err.Error())
+			return
+		}
+	}
+
+	if err := vdcGroup.Delete(); err != nil {
+		resp.Diagnostics.AddError("Error deleting vDC Group", err.Error())
+	}
+}
+
+func (r *vdcGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vdcGroup, err := r.client.GetVDCGroupByNameOrID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing vDC Group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vdcGroup.GetID())...)
+}
+
+// read returns the state of the vDC Group and false if the vDC Group is not found.
+func (r *vdcGroupResource) read(ctx context.Context, vdcGroupID string) (state *vdcGroupResourceModel, found bool, diags diag.Diagnostics) {
+	if !uuid.IsVDCGroup(vdcGroupID) {
+		diags.AddError("Error retrieving vDC Group", fmt.Sprintf("invalid vDC Group ID %q", vdcGroupID))
+		return nil, true, diags
+	}
+
+	vdcGroup, err := r.client.GetVDCGroupByNameOrID(vdcGroupID)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, diags
+		}
+		diags.AddError("Error retrieving vDC Group", err.Error())
+		return nil, true, diags
+	}
+
+	vdcIDs := make([]string, 0, len(vdcGroup.VdcGroup.VdcGroup.ParticipatingOrgVdcs))
+	for _, vdc := range vdcGroup.VdcGroup.VdcGroup.ParticipatingOrgVdcs {
+		vdcIDs = append(vdcIDs, vdc.VdcRef.ID)
+	}
+
+	state = &vdcGroupResourceModel{
+		ID:          types.StringValue(vdcGroup.GetID()),
+		Name:        types.StringValue(vdcGroup.GetName()),
+		Description: types.StringValue(vdcGroup.VdcGroup.VdcGroup.Description),
+		Type:        types.StringValue(vdcGroup.VdcGroup.VdcGroup.Type),
+		DFWEnabled:  types.BoolValue(vdcGroup.VdcGroup.VdcGroup.DfwEnabled),
+		Status:      types.StringValue(vdcGroup.VdcGroup.VdcGroup.Status),
+	}
+
+	var d diag.Diagnostics
+	state.VDCIDs, d = types.SetValueFrom(ctx, types.StringType, vdcIDs)
+	diags.Append(d...)
+
+	return state, true, diags
+}
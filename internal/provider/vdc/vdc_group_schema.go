@@ -0,0 +1,94 @@
+package vdc
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+)
+
+/*
+vdcGroupSchema
+
+This function is used to create the superschema for the vdc group resource.
+*/
+func vdcGroupSchema() superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "Provides a Cloud Avenue vDC group resource. This can be used to create, update and delete a vDC group and to enable its distributed firewall.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the vDC group.",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the vDC group.",
+					Required:            true,
+				},
+			},
+			"description": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The description of the vDC group.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(""),
+				},
+			},
+			"vdc_ids": superschema.SetAttribute{
+				Resource: &schemaR.SetAttribute{
+					MarkdownDescription: "The IDs of the vDCs participating in the vDC group.",
+					Required:            true,
+					ElementType:         types.StringType,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+			},
+			"type": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The scope of the vDC group.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("LOCAL"),
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						stringvalidator.OneOf("LOCAL", "UNIVERSAL"),
+					},
+				},
+			},
+			"dfw_enabled": superschema.BoolAttribute{
+				Resource: &schemaR.BoolAttribute{
+					MarkdownDescription: "Enable the distributed firewall of the vDC group. The distributed firewall must be enabled to manage its rules with the `cloudavenue_vdc_group_firewall` resource.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+			},
+			"status": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The status of the vDC group can be in " + vdcStatuses,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
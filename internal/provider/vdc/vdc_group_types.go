@@ -42,3 +42,13 @@ var vdcModelAttrTypes = map[string]attr.Type{
 	"name":                   types.StringType,
 	"id":                     types.StringType,
 }
+
+type vdcGroupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	VDCIDs      types.Set    `tfsdk:"vdc_ids"`
+	Type        types.String `tfsdk:"type"`
+	DFWEnabled  types.Bool   `tfsdk:"dfw_enabled"`
+	Status      types.String `tfsdk:"status"`
+}
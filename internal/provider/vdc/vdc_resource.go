@@ -22,6 +22,7 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/cloudavenue"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
@@ -136,8 +137,11 @@ func (r *vdcResource) Create(ctx context.Context, req resource.CreateRequest, re
 	var job apiclient.Jobcreated
 	var httpR *http.Response
 
-	cloudavenue.Lock(ctx)
-	defer cloudavenue.Unlock(ctx)
+	if errLock := cloudavenue.Lock(ctxTO, cloudavenue.KeyOrg); errLock != nil {
+		resp.Diagnostics.AddError("Error locking the Cloud Avenue API", errLock.Error())
+		return
+	}
+	defer cloudavenue.Unlock(ctx, cloudavenue.KeyOrg)
 
 	// Call API to create the resource and test for errors.
 	job, httpR, err = r.client.APIClient.VDCApi.CreateOrgVdc(auth, body)
@@ -154,20 +158,17 @@ func (r *vdcResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	// Wait for job to complete
-	errRetry := retry.RetryContext(ctxTO, createTimeout, func() *retry.RetryError {
-		jobStatus, errGetJob := helpers.GetJobStatus(auth, r.client, job.JobId)
-		if errGetJob != nil {
-			retry.NonRetryableError(err)
-		}
-		if !slices.Contains(helpers.JobStateDone(), jobStatus.String()) {
-			return retry.RetryableError(fmt.Errorf("expected job done but was %s", jobStatus))
+	if errJob := helpers.WaitForJob(auth, r.client, job.JobId, createTimeout); errJob != nil {
+		if errors.Is(errJob, helpers.ErrJobStillRunning) {
+			// The vDC is still being created, keep track of the job to resume it on the next refresh.
+			plan.ID = types.StringNull()
+			plan.Metadata = types.SetNull(plan.Metadata.ElementType(ctx))
+			resp.Diagnostics.Append(helpers.SetPendingJob(ctx, resp.Private, job.JobId)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			resp.Diagnostics.Append(helpers.NewJobStillRunningWarning("vDC", job.JobId))
+			return
 		}
-
-		return nil
-	})
-
-	if errRetry != nil {
-		resp.Diagnostics.AddError("Error waiting job to complete", errRetry.Error())
+		resp.Diagnostics.AddError("Error waiting job to complete", errJob.Error())
 		return
 	}
 
@@ -192,6 +193,25 @@ func (r *vdcResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 	plan.ID = types.StringValue(ID)
 
+	// Set metadata
+	adminVDC, err := r.client.GetAdminVDC(client.WithAdminVDCName(plan.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get AdminVDC", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(metadata.Update(ctx, adminVDC, plan.Metadata, types.SetNull(plan.Metadata.ElementType(ctx)))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	md, d := metadata.Get(ctx, adminVDC)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Metadata = md
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "VDC created")
@@ -234,6 +254,18 @@ func (r *vdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	// Resume the creation job interrupted during a previous apply
+	jobStatus, d := helpers.ResumePendingJob(auth, r.client, resp.Private, readTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || jobStatus == helpers.INPROGRESS {
+		return
+	}
+	if jobStatus == helpers.FAILED {
+		// The vDC has not been created, remove it from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Get vDC info
 	vdc, httpR, err := r.client.APIClient.VDCApi.GetOrgVdcByName(auth, state.Name.ValueString())
 
@@ -287,6 +319,19 @@ func (r *vdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		profiles = append(profiles, p)
 	}
 
+	// Get metadata
+	adminVDC, err := r.client.GetAdminVDC(client.WithAdminVDCName(state.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get AdminVDC", err.Error())
+		return
+	}
+
+	md, d := metadata.Get(ctx, adminVDC)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert from the API data model to the Terraform data model
 	// and refresh any attribute values.
 	state = &vdcResourceModel{
@@ -303,6 +348,7 @@ func (r *vdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		MemoryAllocated:        types.Float64Value(vdc.Vdc.MemoryAllocated),
 		VDCStorageBillingModel: types.StringValue(vdc.Vdc.VdcStorageBillingModel),
 		VDCStorageProfiles:     profiles,
+		Metadata:               md,
 	}
 
 	// Save updated state into Terraform state
@@ -311,10 +357,11 @@ func (r *vdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *vdcResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan *vdcResourceModel
+	var plan, state *vdcResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -372,8 +419,11 @@ func (r *vdcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	var job apiclient.Jobcreated
 	var httpR *http.Response
 
-	cloudavenue.Lock(ctx)
-	defer cloudavenue.Unlock(ctx)
+	if errLock := cloudavenue.Lock(ctxTO, cloudavenue.KeyVDC(plan.Name.ValueString())); errLock != nil {
+		resp.Diagnostics.AddError("Error locking the Cloud Avenue API", errLock.Error())
+		return
+	}
+	defer cloudavenue.Unlock(ctx, cloudavenue.KeyVDC(plan.Name.ValueString()))
 
 	// Call API to update the resource and test for errors.
 	job, httpR, err = r.client.APIClient.VDCApi.UpdateOrgVdc(auth, body, body.Vdc.Name)
@@ -407,6 +457,25 @@ func (r *vdcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	// Update metadata
+	adminVDC, err := r.client.GetAdminVDC(client.WithAdminVDCName(plan.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get AdminVDC", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(metadata.Update(ctx, adminVDC, plan.Metadata, state.Metadata)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	md, d := metadata.Get(ctx, adminVDC)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Metadata = md
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "VDC updated")
@@ -449,8 +518,26 @@ func (r *vdcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	cloudavenue.Lock(ctx)
-	defer cloudavenue.Unlock(ctx)
+	if errLock := cloudavenue.Lock(ctxTO, cloudavenue.KeyVDC(state.Name.ValueString())); errLock != nil {
+		resp.Diagnostics.AddError("Error locking the Cloud Avenue API", errLock.Error())
+		return
+	}
+	defer cloudavenue.Unlock(ctx, cloudavenue.KeyVDC(state.Name.ValueString()))
+
+	// Wait for the creation job interrupted during a previous apply
+	jobStatus, d := helpers.ResumePendingJob(auth, r.client, req.Private, deleteTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	switch jobStatus {
+	case helpers.FAILED:
+		// The vDC has not been created, nothing to delete
+		return
+	case helpers.INPROGRESS:
+		resp.Diagnostics.AddError("Error deleting vDC", "The vDC is still being created, it cannot be deleted yet.")
+		return
+	}
 
 	// Delete the VDC
 	job, httpR, err := r.client.APIClient.VDCApi.DeleteOrgVdc(auth, state.Name.ValueString())
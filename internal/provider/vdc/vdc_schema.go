@@ -15,6 +15,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 )
 
 /*
@@ -28,7 +31,7 @@ func vdcSchema() superschema.Schema {
 			MarkdownDescription: "Provides a Cloud Avenue vDC (Virtual Data Center) ",
 		},
 		Resource: superschema.SchemaDetails{
-			MarkdownDescription: "resource. This can be used to create, update and delete vDC.\n\n",
+			MarkdownDescription: "resource. This can be used to create, update and delete vDC.\n\n" + helpers.PendingJobNote,
 		},
 		DataSource: superschema.SchemaDetails{
 			MarkdownDescription: "data source. This can be used to reference a vDC and use its data within other resources or data sources.",
@@ -245,6 +248,7 @@ func vdcSchema() superschema.Schema {
 					},
 				},
 			},
+			metadata.SchemaName: metadata.SuperSchema(),
 		},
 	}
 }
@@ -19,6 +19,7 @@ type vdcDataSourceModel struct {
 	VDCStorageBillingModel types.String             `tfsdk:"storage_billing_model"`
 	VDCStorageProfiles     []vdcStorageProfileModel `tfsdk:"storage_profiles"`
 	VDCGroup               types.String             `tfsdk:"vdc_group"`
+	Metadata               types.Set                `tfsdk:"metadata"`
 }
 
 type vdcResourceModel struct {
@@ -35,4 +36,5 @@ type vdcResourceModel struct {
 	VDCStorageBillingModel types.String             `tfsdk:"storage_billing_model"`
 	VDCStorageProfiles     []vdcStorageProfileModel `tfsdk:"storage_profiles"`
 	VDCGroup               types.String             `tfsdk:"vdc_group"`
+	Metadata               types.Set                `tfsdk:"metadata"`
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
@@ -69,6 +70,12 @@ func (d *vdcsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	metadataFilter := make(map[string]string)
+	resp.Diagnostics.Append(data.MetadataFilter.ElementsAs(ctx, &metadataFilter, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vdcs, httpR, err := d.client.APIClient.VDCApi.GetOrgVdcs(d.client.Auth)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vdcs detail, got error: %s", err))
@@ -78,9 +85,28 @@ func (d *vdcsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		err = errors.Join(err, httpR.Body.Close())
 	}()
 
-	data = vdcsDataSourceModel{}
+	data = vdcsDataSourceModel{
+		MetadataFilter: data.MetadataFilter,
+	}
 
 	for _, v := range vdcs {
+		if len(metadataFilter) > 0 {
+			adminVDC, err := d.client.GetAdminVDC(client.WithAdminVDCName(v.VdcName))
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to get AdminVDC", err.Error())
+				return
+			}
+
+			match, err := metadata.Match(adminVDC, metadataFilter)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to get vDC metadata", err.Error())
+				return
+			}
+			if !match {
+				continue
+			}
+		}
+
 		data.VDCs = append(data.VDCs, vdcRef{
 			VDCName: types.StringValue(v.VdcName),
 			VDCUuid: types.StringValue(v.VdcUuid),
@@ -2,6 +2,8 @@ package vdc
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 )
 
 func vdcsSchema() schema.Schema {
@@ -28,6 +30,7 @@ func vdcsSchema() schema.Schema {
 					},
 				},
 			},
+			metadata.SchemaFilterName: metadata.FilterSchema(),
 		},
 	}
 }
@@ -3,8 +3,9 @@ package vdc
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type vdcsDataSourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	VDCs []vdcRef     `tfsdk:"vdcs"`
+	ID             types.String `tfsdk:"id"`
+	VDCs           []vdcRef     `tfsdk:"vdcs"`
+	MetadataFilter types.Map    `tfsdk:"metadata_filter"`
 }
 
 type vdcRef struct {
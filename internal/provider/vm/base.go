@@ -3,6 +3,5 @@ package vm
 const (
 	categoryName = "vm"
 
-	poweredON  = "POWERED_ON"
-	poweredOFF = "POWERED_OFF"
+	poweredON = "POWERED_ON"
 )
@@ -12,6 +12,7 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminvdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm"
@@ -160,6 +161,13 @@ func (d *vmDataSource) read(ctx context.Context, dm, dmPlan *VMDataSourceModel)
 		return
 	}
 
+	// ? Metadata
+	md, mdDiags := metadata.Get(ctx, d.vm)
+	diags.Append(mdDiags...)
+	if diags.HasError() {
+		return
+	}
+
 	return &VMDataSourceModel{
 		ID:          types.StringValue(d.vm.GetID()),
 		VDC:         types.StringValue(d.vdc.GetName()),
@@ -167,8 +175,9 @@ func (d *vmDataSource) read(ctx context.Context, dm, dmPlan *VMDataSourceModel)
 		VappID:      types.StringValue(d.vapp.GetID()),
 		VappName:    types.StringValue(d.vapp.GetName()),
 		Description: dm.Description,
-		State:       stateStruct.ToPlan(ctx),
+		State:       stateStruct.ToDataSourcePlan(ctx),
 		Resource:    d.vm.ResourceRead(ctx).ToPlan(ctx, networks),
 		Settings:    settings.ToPlan(ctx),
+		Metadata:    md,
 	}, nil
 }
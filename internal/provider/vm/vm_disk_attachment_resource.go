@@ -0,0 +1,322 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &vmDiskAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &vmDiskAttachmentResource{}
+	_ resource.ResourceWithImportState = &vmDiskAttachmentResource{}
+)
+
+// NewVMDiskAttachmentResource is a helper function to simplify the provider implementation.
+func NewVMDiskAttachmentResource() resource.Resource {
+	return &vmDiskAttachmentResource{}
+}
+
+// vmDiskAttachmentResource is the resource implementation.
+type vmDiskAttachmentResource struct {
+	client *client.CloudAvenue
+	vdc    vdc.VDC
+	vapp   vapp.VAPP
+	vm     vm.VM
+}
+
+// Init Initializes the resource.
+func (r *vmDiskAttachmentResource) Init(_ context.Context, rm *vmDiskAttachmentResourceModel) (diags diag.Diagnostics) {
+	r.vdc, diags = vdc.Init(r.client, rm.VDC)
+	if diags.HasError() {
+		return
+	}
+
+	r.vapp, diags = vapp.Init(r.client, r.vdc, rm.VAppID, rm.VAppName)
+	if diags.HasError() {
+		return
+	}
+
+	r.vm, diags = vm.Get(r.vapp, vm.GetVMOpts{
+		ID:   rm.VMID,
+		Name: rm.VMName,
+	})
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *vmDiskAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_disk_attachment"
+}
+
+// Schema defines the schema for the resource.
+func (r *vmDiskAttachmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = vmDiskAttachmentSchema().GetResource(ctx)
+}
+
+func (r *vmDiskAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *vmDiskAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &vmDiskAttachmentResourceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.vm.LockVM(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer r.vm.UnlockVM(ctx)
+
+	disk, err := r.vdc.GetDiskById(plan.DiskID.ValueString(), true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving disk", err.Error())
+		return
+	}
+
+	if err := r.vm.Refresh(); err != nil {
+		resp.Diagnostics.AddError("Error refreshing VM", err.Error())
+		return
+	}
+
+	// The first available bus and unit are used if they are not set.
+	busNumber, unitNumber := plan.BusNumber, plan.UnitNumber
+	if busNumber.IsUnknown() || unitNumber.IsUnknown() {
+		busNumber, unitNumber = types.Int64Null(), types.Int64Null()
+	}
+
+	task, err := r.vm.AttachDisk(r.vm.AttachDiskSettings(busNumber, unitNumber, disk.Disk.HREF))
+	if err != nil {
+		resp.Diagnostics.AddError("Error attaching disk", fmt.Sprintf("error attaching disk %s: %v", disk.Disk.Name, err))
+		return
+	}
+
+	if err = task.WaitTaskCompletion(); err != nil {
+		resp.Diagnostics.AddError("Error attaching disk", fmt.Sprintf("error attaching disk %s: %v", disk.Disk.Name, err))
+		return
+	}
+
+	state, found, d := r.read(ctx, plan)
+	if !found {
+		resp.Diagnostics.AddError("Error retrieving disk attachment", "The disk is not attached to the VM after its attachment")
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *vmDiskAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &vmDiskAttachmentResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	d := r.Init(ctx, state)
+	if d.HasError() {
+		if d.Contains(diag.NewErrorDiagnostic("VM not found", govcd.ErrorEntityNotFound.Error())) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	stateRefreshed, found, d := r.read(ctx, state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// All the attributes require a replacement, nothing to do.
+func (r *vmDiskAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := &vmDiskAttachmentResourceModel{}
+
+	// Get current plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *vmDiskAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &vmDiskAttachmentResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	d := r.Init(ctx, state)
+	if d.HasError() {
+		if d.Contains(diag.NewErrorDiagnostic("VM not found", govcd.ErrorEntityNotFound.Error())) {
+			// The VM is already deleted, the disk is detached.
+			return
+		}
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.vm.LockVM(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer r.vm.UnlockVM(ctx)
+
+	_, found, d := r.read(ctx, state)
+	if !found {
+		// The disk is already detached.
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disk, err := r.vdc.GetDiskById(state.DiskID.ValueString(), true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving disk", err.Error())
+		return
+	}
+
+	task, err := r.vm.DetachDisk(&govcdtypes.DiskAttachOrDetachParams{
+		Disk: &govcdtypes.Reference{HREF: disk.Disk.HREF},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error detaching disk", fmt.Sprintf("error detaching disk %s: %v", disk.Disk.Name, err))
+		return
+	}
+
+	if err = task.WaitTaskCompletion(); err != nil {
+		resp.Diagnostics.AddError("Error detaching disk", fmt.Sprintf("error detaching disk %s: %v", disk.Disk.Name, err))
+	}
+}
+
+func (r *vmDiskAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format : vdc.vapp_name.vm_name_or_id.disk_id or vapp_name.vm_name_or_id.disk_id
+	idParts := strings.Split(req.ID, ".")
+
+	if len(idParts) != 3 && len(idParts) != 4 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: vdc.vapp_name.vm_name_or_id.disk_id or vapp_name.vm_name_or_id.disk_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if len(idParts) == 4 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc"), idParts[0])...)
+		idParts = idParts[1:]
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vapp_name"), idParts[0])...)
+	if uuid.IsVM(idParts[1]) {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_id"), idParts[1])...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_name"), idParts[1])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("disk_id"), idParts[2])...)
+}
+
+// read returns the state of the attachment. found is false if the disk is not attached to the VM.
+func (r *vmDiskAttachmentResource) read(_ context.Context, rm *vmDiskAttachmentResourceModel) (state *vmDiskAttachmentResourceModel, found bool, diags diag.Diagnostics) {
+	disk, err := r.vdc.GetDiskById(rm.DiskID.ValueString(), true)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving disk", err.Error())
+		return nil, true, diags
+	}
+
+	if err := r.vm.Refresh(); err != nil {
+		diags.AddError("Error refreshing VM", err.Error())
+		return nil, true, diags
+	}
+
+	for _, diskSettings := range r.vm.GetDiskSettings() {
+		if diskSettings.Disk == nil || diskSettings.Disk.HREF != disk.Disk.HREF {
+			continue
+		}
+
+		return &vmDiskAttachmentResourceModel{
+			ID:         types.StringValue(disk.Disk.Id),
+			VDC:        types.StringValue(r.vdc.GetName()),
+			VAppID:     rm.VAppID,
+			VAppName:   rm.VAppName,
+			VMID:       rm.VMID,
+			VMName:     rm.VMName,
+			DiskID:     types.StringValue(disk.Disk.Id),
+			BusNumber:  types.Int64Value(int64(diskSettings.BusNumber)),
+			UnitNumber: types.Int64Value(int64(diskSettings.UnitNumber)),
+		}, true, nil
+	}
+
+	return nil, false, nil
+}
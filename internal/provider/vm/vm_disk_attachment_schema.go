@@ -0,0 +1,106 @@
+package vm
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+)
+
+// vmDiskAttachmentSchema returns the super schema of the vm_disk_attachment resource.
+func vmDiskAttachmentSchema() superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The VM disk attachment resource allows you to attach an independent disk (see `cloudavenue_disk`) to a VM. Changing the VM detaches the disk from the old VM and attaches it to the new one, the disk is not destroyed.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the attachment (the ID of the disk).",
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"vdc":       vdc.SuperSchema(),
+			"vapp_id":   vapp.SuperSchema()["vapp_id"],
+			"vapp_name": vapp.SuperSchema()["vapp_name"],
+			"vm_name": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the VM to which the disk is attached.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("vm_id"), path.MatchRoot("vm_name")),
+					},
+				},
+			},
+			"vm_id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the VM to which the disk is attached.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("vm_name"), path.MatchRoot("vm_id")),
+					},
+				},
+			},
+			"disk_id": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the independent disk.",
+					Required:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"bus_number": superschema.Int64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: "The bus number of the disk controller. If not set, the disk is attached to the first available bus.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+						int64planmodifier.RequiresReplace(),
+					},
+					Validators: []validator.Int64{
+						int64validator.Between(0, 3),
+						int64validator.AlsoRequires(path.MatchRoot("unit_number")),
+					},
+				},
+			},
+			"unit_number": superschema.Int64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: "The unit number of the disk controller. If not set, the disk is attached to the first available unit.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+						int64planmodifier.RequiresReplace(),
+					},
+					Validators: []validator.Int64{
+						int64validator.Between(0, 15),
+						int64validator.AlsoRequires(path.MatchRoot("bus_number")),
+					},
+				},
+			},
+		},
+	}
+}
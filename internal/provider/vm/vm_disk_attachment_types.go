@@ -0,0 +1,17 @@
+package vm
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vmDiskAttachmentResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	VDC        types.String `tfsdk:"vdc"`
+	VAppID     types.String `tfsdk:"vapp_id"`
+	VAppName   types.String `tfsdk:"vapp_name"`
+	VMID       types.String `tfsdk:"vm_id"`
+	VMName     types.String `tfsdk:"vm_name"`
+	DiskID     types.String `tfsdk:"disk_id"`
+	BusNumber  types.Int64  `tfsdk:"bus_number"`
+	UnitNumber types.Int64  `tfsdk:"unit_number"`
+}
@@ -95,8 +95,9 @@ func DiskSuperSchema() superschema.Schema {
 					Computed:            true,
 				},
 				Resource: &schemaR.BoolAttribute{
-					Optional: true,
-					Default:  booldefault.StaticBool(false),
+					Optional:           true,
+					DeprecationMessage: "Detachable disks are managed by the `cloudavenue_disk` and `cloudavenue_vm_disk_attachment` resources. This attribute will be removed in a future release.",
+					Default:            booldefault.StaticBool(false),
 					PlanModifiers: []planmodifier.Bool{
 						boolplanmodifier.UseStateForUnknown(),
 						boolplanmodifier.RequiresReplace(),
@@ -17,6 +17,7 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminvdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm"
@@ -231,6 +232,17 @@ func (r *vmResource) Create(ctx context.Context, req resource.CreateRequest, res
 		return
 	}
 
+	resp.Diagnostics.Append(metadata.Update(ctx, r.vm, plan.Metadata, types.SetNull(plan.Metadata.ElementType(ctx)))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	md, d := metadata.Get(ctx, r.vm)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tfState := *plan
 	tfState.ID = types.StringValue(r.vm.GetID())
 	tfState.VappID = types.StringValue(r.vapp.GetID())
@@ -239,6 +251,7 @@ func (r *vmResource) Create(ctx context.Context, req resource.CreateRequest, res
 	tfState.VDC = types.StringValue(r.vdc.GetName())
 	tfState.Settings = settings.ToPlan(ctx)
 	tfState.Resource = r.vm.ResourceRead(ctx).ToPlan(ctx, networks)
+	tfState.Metadata = md
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, tfState)...)
@@ -517,14 +530,9 @@ func (r *vmResource) Update(ctx context.Context, req resource.UpdateRequest, res
 	// ! Hot Update
 
 	// ! Cold Update
-	vmStatusBeforeUpdate, err := r.vm.GetStatus()
-	if err != nil {
-		resp.Diagnostics.AddError("Error retrieving VM status", err.Error())
-		return
-	}
+	powerPolicy := allStructsPlan.State.PowerPolicy()
 
-	if !allStructsPlan.State.PowerON.Equal(allStructsState.State.PowerON) ||
-		!allStructsPlan.Settings.ExposeHardwareVirtualization.Equal(allStructsState.Settings.ExposeHardwareVirtualization) ||
+	if !allStructsPlan.Settings.ExposeHardwareVirtualization.Equal(allStructsState.Settings.ExposeHardwareVirtualization) ||
 		!allStructsPlan.Settings.OsType.Equal(allStructsState.Settings.OsType) ||
 		!allStructsPlan.Resource.CPUHotAddEnabled.Equal(allStructsState.Resource.CPUHotAddEnabled) ||
 		!allStructsPlan.Resource.MemoryHotAddEnabled.Equal(allStructsState.Resource.MemoryHotAddEnabled) ||
@@ -532,18 +540,10 @@ func (r *vmResource) Update(ctx context.Context, req resource.UpdateRequest, res
 		needColdChange.cpu ||
 		needColdChange.memory ||
 		needColdChange.network {
-		if vmStatusBeforeUpdate != poweredOFF {
-			task, err := r.vm.Undeploy()
-			if err != nil {
-				resp.Diagnostics.AddError("Error undeploying VM", err.Error())
-				return
-			}
-
-			err = task.WaitTaskCompletion()
-			if err != nil {
-				resp.Diagnostics.AddError("Error waiting undeploying VM", err.Error())
-				return
-			}
+		// The VM is stopped according to the power policy, it is powered on again below if power_on is true.
+		if err := r.vm.Stop(ctx, r.client, powerPolicy, true); err != nil {
+			resp.Diagnostics.AddError("Error stopping VM", err.Error())
+			return
 		}
 
 		// * ExposeHardwareVirtualization
@@ -668,18 +668,10 @@ func (r *vmResource) Update(ctx context.Context, req resource.UpdateRequest, res
 		}
 
 		if allStructsPlan.Settings.Customization.Attributes()["force"].(types.Bool).ValueBool() {
-			if vmStatus != poweredOFF {
-				task, err := r.vm.Undeploy()
-				if err != nil {
-					resp.Diagnostics.AddError("Error undeploying VM", err.Error())
-					return
-				}
-
-				err = task.WaitTaskCompletion()
-				if err != nil {
-					resp.Diagnostics.AddError("Error waiting undeploying VM", err.Error())
-					return
-				}
+			// The customization is applied on the next deployment of the VM.
+			if err := r.vm.Stop(ctx, r.client, powerPolicy, true); err != nil {
+				resp.Diagnostics.AddError("Error stopping VM", err.Error())
+				return
 			}
 
 			if err := r.vm.PowerOnAndForceCustomization(); err != nil {
@@ -687,18 +679,14 @@ func (r *vmResource) Update(ctx context.Context, req resource.UpdateRequest, res
 				return
 			}
 		}
-	} else if !allStructsPlan.State.PowerON.ValueBool() && vmStatus != poweredOFF {
-		task, err := r.vm.Undeploy()
-		if err != nil {
-			resp.Diagnostics.AddError("Error undeploying VM", err.Error())
-			return
-		}
+	} else if err := r.vm.Stop(ctx, r.client, powerPolicy, false); err != nil {
+		resp.Diagnostics.AddError("Error stopping VM", err.Error())
+		return
+	}
 
-		err = task.WaitTaskCompletion()
-		if err != nil {
-			resp.Diagnostics.AddError("Error waiting undeploying VM", err.Error())
-			return
-		}
+	resp.Diagnostics.Append(metadata.Update(ctx, r.vm, plan.Metadata, state.Metadata)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	newPlan, d := r.read(ctx, state, plan)
@@ -1241,6 +1229,15 @@ func (r *vmResource) read(ctx context.Context, rm, rmPlan *vm.VMResourceModel) (
 		return
 	}
 
+	// The power policy is not returned by the API, it is kept from the plan.
+	statePlan, d := rmPlan.StateFromPlan(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	stateStruct.PowerAction = statePlan.PowerAction
+	stateStruct.ShutdownTimeout = statePlan.ShutdownTimeout
+
 	// ? Resource
 	networks, err := r.vm.NetworksRead()
 	if err != nil {
@@ -1261,6 +1258,13 @@ func (r *vmResource) read(ctx context.Context, rm, rmPlan *vm.VMResourceModel) (
 		return
 	}
 
+	// ? Metadata
+	md, d := metadata.Get(ctx, r.vm)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
 	return &vm.VMResourceModel{
 		ID:          types.StringValue(r.vm.GetID()),
 		VDC:         types.StringValue(r.vdc.GetName()),
@@ -1272,5 +1276,6 @@ func (r *vmResource) read(ctx context.Context, rm, rmPlan *vm.VMResourceModel) (
 		Resource:    r.vm.ResourceRead(ctx).ToPlan(ctx, networks),
 		Settings:    settings.ToPlan(ctx),
 		DeployOS:    rm.DeployOS,
+		Metadata:    md,
 	}, nil
 }
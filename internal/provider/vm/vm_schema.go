@@ -30,6 +30,7 @@ import (
 	fint64validator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/int64validator"
 	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
 
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/metadata"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/storageprofile"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm"
@@ -215,6 +216,28 @@ func vmSuperSchema(_ context.Context) superschema.Schema {
 							Computed:            true,
 						},
 					},
+					"power_action": superschema.StringAttribute{
+						Resource: &schemaR.StringAttribute{
+							MarkdownDescription: "The action used when the VM must be stopped (`power_on` set to `false` or change of an attribute that cannot be hot updated). `power_off` powers off the VM immediately. `shutdown` shuts down the guest OS and powers off the VM if it is still running after `shutdown_timeout` seconds (VMware Tools must be installed in the VM). `suspend` suspends the VM, `shutdown` is used instead when the VM must be stopped to apply a change. `reset` powers off the VM without undeploying it to apply a change and powers it on again right after the change, `power_off` is used instead when `power_on` is set to `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(vm.PowerActionPowerOff),
+							Validators: []validator.String{
+								stringvalidator.OneOf(vm.PowerActions...),
+							},
+						},
+					},
+					"shutdown_timeout": superschema.Int64Attribute{
+						Resource: &schemaR.Int64Attribute{
+							MarkdownDescription: "The time in seconds given to the guest OS to shut down when `power_action` is `shutdown`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(vm.DefaultShutdownTimeout),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
 				},
 			},
 			"resource": superschema.SingleNestedAttribute{
@@ -814,6 +837,7 @@ func vmSuperSchema(_ context.Context) superschema.Schema {
 					},
 				},
 			},
+			metadata.SchemaName: metadata.SuperSchema(),
 		},
 	}
 }
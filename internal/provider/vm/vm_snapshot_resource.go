@@ -0,0 +1,315 @@
+// Package vm provides a Terraform resource.
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &vmSnapshotResource{}
+	_ resource.ResourceWithConfigure   = &vmSnapshotResource{}
+	_ resource.ResourceWithImportState = &vmSnapshotResource{}
+)
+
+// NewVMSnapshotResource is a helper function to simplify the provider implementation.
+func NewVMSnapshotResource() resource.Resource {
+	return &vmSnapshotResource{}
+}
+
+// vmSnapshotResource is the resource implementation.
+type vmSnapshotResource struct {
+	client *client.CloudAvenue
+	vdc    vdc.VDC
+	vapp   vapp.VAPP
+	vm     vm.VM
+}
+
+// Metadata returns the resource type name.
+func (r *vmSnapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_" + "snapshot"
+}
+
+// Schema defines the schema for the resource.
+func (r *vmSnapshotResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = vmSnapshotSchema().GetResource(ctx)
+}
+
+// Init resource used to initialize the resource.
+func (r *vmSnapshotResource) Init(_ context.Context, rm *vmSnapshotResourceModel) (diags diag.Diagnostics) {
+	r.vdc, diags = vdc.Init(r.client, rm.VDC)
+	if diags.HasError() {
+		return
+	}
+
+	r.vapp, diags = vapp.Init(r.client, r.vdc, rm.VAppID, rm.VAppName)
+	if diags.HasError() {
+		return
+	}
+
+	r.vm, diags = vm.Get(r.vapp, vm.GetVMOpts{
+		ID:   rm.VMID,
+		Name: rm.VMName,
+	})
+	return
+}
+
+func (r *vmSnapshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *vmSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &vmSnapshotResourceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.vm.LockVM(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer r.vm.UnlockVM(ctx)
+
+	// A VM has at most one snapshot, creating a new one would silently replace the existing one.
+	if r.vm.GetSnapshot() != nil {
+		resp.Diagnostics.AddError(
+			"VM snapshot already exists",
+			fmt.Sprintf("The VM %q already has a snapshot. Import it or remove it before creating a new one.", r.vm.GetName()),
+		)
+		return
+	}
+
+	if err := r.client.CreateVMSnapshot(*r.vm.VM, plan.ToVMSnapshotParams()); err != nil {
+		resp.Diagnostics.AddError("Error creating VM snapshot", err.Error())
+		return
+	}
+
+	state, found, d := r.read(plan)
+	if !found {
+		resp.Diagnostics.AddError("Error creating VM snapshot", "The snapshot was not found after its creation.")
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *vmSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &vmSnapshotResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	d := r.Init(ctx, state)
+	if d.HasError() {
+		if d.Contains(diag.NewErrorDiagnostic("VM not found", govcd.ErrorEntityNotFound.Error())) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	stateRefreshed, found, d := r.read(state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *vmSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		plan  = &vmSnapshotResourceModel{}
+		state = &vmSnapshotResourceModel{}
+	)
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Only the revert_trigger attribute can be updated, all the other attributes require a replacement.
+	*/
+
+	if !plan.RevertTrigger.IsNull() && !plan.RevertTrigger.Equal(state.RevertTrigger) {
+		resp.Diagnostics.Append(r.vm.LockVM(ctx)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		defer r.vm.UnlockVM(ctx)
+
+		if err := r.client.RevertVMSnapshot(*r.vm.VM); err != nil {
+			resp.Diagnostics.AddError("Error reverting VM snapshot", err.Error())
+			return
+		}
+	}
+
+	stateRefreshed, found, d := r.read(plan)
+	if !found {
+		resp.Diagnostics.AddError("Error updating VM snapshot", "The snapshot was not found.")
+		return
+	}
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *vmSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &vmSnapshotResourceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.vm.GetSnapshot() == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.vm.LockVM(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer r.vm.UnlockVM(ctx)
+
+	if err := r.client.RemoveVMSnapshots(*r.vm.VM); err != nil {
+		resp.Diagnostics.AddError("Error deleting VM snapshot", err.Error())
+	}
+}
+
+func (r *vmSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ".")
+
+	if len(idParts) != 3 && len(idParts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: vdc.vapp_name.vm_name_or_id or vapp_name.vm_name_or_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if len(idParts) == 3 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc"), idParts[0])...)
+		idParts = idParts[1:]
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vapp_name"), idParts[0])...)
+	if uuid.IsVM(idParts[1]) {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_id"), idParts[1])...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_name"), idParts[1])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("memory"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("quiesce"), false)...)
+}
+
+// read returns the state of the snapshot and false if the VM has no snapshot.
+// The description, memory, quiesce and revert_trigger attributes are not returned by the API and are kept from the given model.
+func (r *vmSnapshotResource) read(rm *vmSnapshotResourceModel) (state *vmSnapshotResourceModel, found bool, diags diag.Diagnostics) {
+	if err := r.vm.Refresh(); err != nil {
+		diags.AddError("Error refreshing VM", err.Error())
+		return nil, true, diags
+	}
+
+	snapshot := r.vm.GetSnapshot()
+	if snapshot == nil {
+		return nil, false, nil
+	}
+
+	state = &vmSnapshotResourceModel{
+		ID:            types.StringValue(r.vm.GetID()),
+		VDC:           types.StringValue(r.vdc.GetName()),
+		VAppID:        rm.VAppID,
+		VAppName:      rm.VAppName,
+		VMID:          types.StringValue(r.vm.GetID()),
+		VMName:        types.StringValue(r.vm.GetName()),
+		Description:   rm.Description,
+		Memory:        rm.Memory,
+		Quiesce:       rm.Quiesce,
+		RevertTrigger: rm.RevertTrigger,
+		CreatedAt:     types.StringValue(snapshot.Created),
+		PoweredOn:     types.BoolValue(snapshot.PoweredOn),
+		Size:          types.Int64Value(int64(snapshot.Size)),
+	}
+
+	return state, true, nil
+}
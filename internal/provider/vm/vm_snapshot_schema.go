@@ -0,0 +1,159 @@
+package vm
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+)
+
+/*
+vmSnapshotSchema
+
+This function is used to create the superschema for the VM snapshot resource.
+*/
+func vmSnapshotSchema() superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_vm_snapshot` resource allows you to manage the snapshot of a VM. The presence of the resource represents the snapshot: creating the resource takes the snapshot and destroying it removes the snapshot.\n\n" +
+				"~> **Note:** A VM has at most one snapshot. Only one `cloudavenue_vm_snapshot` resource must be declared per VM.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the snapshot. This is the ID of the VM.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"vdc":       vdc.SuperSchema(),
+			"vapp_id":   vapp.SuperSchema()["vapp_id"],
+			"vapp_name": vapp.SuperSchema()["vapp_name"],
+			"vm_name": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the VM to snapshot.",
+					Optional:            true,
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("vm_id"), path.MatchRoot("vm_name")),
+					},
+				},
+			},
+			"vm_id": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the VM to snapshot.",
+					Optional:            true,
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("vm_id"), path.MatchRoot("vm_name")),
+					},
+				},
+			},
+			"description": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The description of the snapshot.",
+					Optional:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"memory": superschema.BoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Include the memory of the VM in the snapshot. When the snapshot is reverted, a powered on VM is restored in its running state.",
+					Optional:            true,
+					Computed:            true,
+				},
+				Resource: &schemaR.BoolAttribute{
+					Default: booldefault.StaticBool(false),
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"quiesce": superschema.BoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Quiesce the file system of the VM before taking the snapshot. VMware Tools must be installed in the VM.",
+					Optional:            true,
+					Computed:            true,
+				},
+				Resource: &schemaR.BoolAttribute{
+					Default: booldefault.StaticBool(false),
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+			"revert_trigger": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "An arbitrary value (e.g. a timestamp or a pipeline run ID). Each time the value changes, the VM is reverted to the snapshot. The VM is not reverted when the resource is created. If the snapshot does not include the memory, the VM is powered off after the revert.",
+					Optional:            true,
+				},
+			},
+			"created_at": superschema.StringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The creation date of the snapshot.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"powered_on": superschema.BoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Define if the VM was powered on when the snapshot was taken.",
+					Computed:            true,
+				},
+				Resource: &schemaR.BoolAttribute{
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"size": superschema.Int64Attribute{
+				Common: &schemaR.Int64Attribute{
+					MarkdownDescription: "The size of the snapshot in bytes.",
+					Computed:            true,
+				},
+				Resource: &schemaR.Int64Attribute{
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package vm
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+)
+
+type vmSnapshotResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	VDC           types.String `tfsdk:"vdc"`
+	VAppID        types.String `tfsdk:"vapp_id"`
+	VAppName      types.String `tfsdk:"vapp_name"`
+	VMID          types.String `tfsdk:"vm_id"`
+	VMName        types.String `tfsdk:"vm_name"`
+	Description   types.String `tfsdk:"description"`
+	Memory        types.Bool   `tfsdk:"memory"`
+	Quiesce       types.Bool   `tfsdk:"quiesce"`
+	RevertTrigger types.String `tfsdk:"revert_trigger"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	PoweredOn     types.Bool   `tfsdk:"powered_on"`
+	Size          types.Int64  `tfsdk:"size"`
+}
+
+// ToVMSnapshotParams returns the parameters used to create the snapshot.
+func (rm *vmSnapshotResourceModel) ToVMSnapshotParams() client.VMSnapshotParams {
+	return client.VMSnapshotParams{
+		Description: rm.Description.ValueString(),
+		Memory:      rm.Memory.ValueBool(),
+		Quiesce:     rm.Quiesce.ValueBool(),
+	}
+}
@@ -12,4 +12,5 @@ type VMDataSourceModel struct { //nolint:revive
 	State       types.Object `tfsdk:"state"`
 	Resource    types.Object `tfsdk:"resource"`
 	Settings    types.Object `tfsdk:"settings"`
+	Metadata    types.Set    `tfsdk:"metadata"`
 }
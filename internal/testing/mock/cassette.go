@@ -0,0 +1,154 @@
+package mock
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// urlPlaceholder replaces the URL of the real Cloud Avenue API in the recorded interactions.
+const urlPlaceholder = "{{MOCK_URL}}"
+
+// mockToken replaces the tokens in the recorded interactions.
+const mockToken = "mock-token"
+
+var (
+	// recordedHeaders are the response headers kept in the recorded interactions.
+	recordedHeaders = []string{"Content-Type", "Location", "Link", "X-Vmware-Vcloud-Access-Token", "X-Vmware-Vcloud-Token-Type"}
+	// rewrittenHeaders are the response headers which can contain the URL of the API.
+	rewrittenHeaders = []string{"Location", "Link"}
+	// tokenHeaders are the response headers containing a token.
+	tokenHeaders = []string{"X-Vmware-Vcloud-Access-Token"}
+	// tokenFields matches the tokens in the JSON responses, e.g. of the API token exchange.
+	tokenFields = regexp.MustCompile(`"(access_token|refresh_token)"\s*:\s*"[^"]*"`)
+)
+
+// cassette is the list of the interactions recorded for a test.
+type cassette struct {
+	// Org and VDC are the organization and the vDC used when recording the test.
+	Org          string         `json:"org"`
+	VDC          string         `json:"vdc"`
+	Interactions []*interaction `json:"interactions"`
+
+	mu sync.Mutex
+	// played is the number of interactions already replayed for a request.
+	played map[string]int
+}
+
+// interaction is a recorded request and its response.
+type interaction struct {
+	Method string      `json:"method"`
+	URI    string      `json:"uri"`
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+func newCassette(org, vdc string) *cassette {
+	return &cassette{
+		Org:          org,
+		VDC:          vdc,
+		Interactions: make([]*interaction, 0),
+		played:       make(map[string]int),
+	}
+}
+
+// loadCassette reads a cassette from a file.
+func loadCassette(path string) (*cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := newCassette("", "")
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// save writes the cassette to a file.
+func (c *cassette) save(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// record adds an interaction to the cassette. The URL of the real API and the tokens are removed.
+func (c *cassette) record(method, uri string, status int, header http.Header, body, origin string) {
+	i := &interaction{
+		Method: method,
+		URI:    uri,
+		Status: status,
+		Header: make(http.Header),
+		Body:   tokenFields.ReplaceAllString(strings.ReplaceAll(body, origin, urlPlaceholder), `"$1":"`+mockToken+`"`),
+	}
+
+	for _, key := range recordedHeaders {
+		for _, value := range header.Values(key) {
+			i.Header.Add(key, strings.ReplaceAll(value, origin, urlPlaceholder))
+		}
+	}
+	for _, key := range tokenHeaders {
+		if i.Header.Get(key) != "" {
+			i.Header.Set(key, mockToken)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Interactions = append(c.Interactions, i)
+}
+
+// next returns the next recorded interaction for a request, in the order of the recording.
+// Once all the interactions of a request are replayed, the last one is returned again.
+// It returns nil if the request has not been recorded.
+func (c *cassette) next(method, uri string) *interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := method + " " + uri
+
+	var last *interaction
+	count := 0
+	for _, i := range c.Interactions {
+		if i.Method != method || i.URI != uri {
+			continue
+		}
+		if count == c.played[key] {
+			c.played[key]++
+			return i
+		}
+		last = i
+		count++
+	}
+
+	return last
+}
+
+// write writes the recorded response with the URL of the mock server.
+func (i *interaction) write(w http.ResponseWriter, serverURL string) {
+	for key, values := range i.Header {
+		for _, value := range values {
+			w.Header().Add(key, strings.ReplaceAll(value, urlPlaceholder, serverURL))
+		}
+	}
+	w.WriteHeader(i.Status)
+	_, _ = w.Write([]byte(strings.ReplaceAll(i.Body, urlPlaceholder, serverURL)))
+}
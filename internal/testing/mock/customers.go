@@ -0,0 +1,493 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// customersAPIPrefix is the prefix of the Cloud Avenue customer API.
+	customersAPIPrefix = "/api/customers/"
+	// sessionsPath is the path of the session (token) endpoint.
+	sessionsPath = "/cloudapi/1.0.0/sessions"
+
+	jobStatusDone       = "DONE"
+	jobStatusInProgress = "IN_PROGRESS"
+)
+
+// Paths of the customer API, as used by the cloudavenue-sdk-go client.
+const (
+	pathVDCs              = "/api/customers/v2.0/vdcs"
+	pathVDC               = "/api/customers/v2.0/vdcs/{vdc}"
+	pathVDCEdges          = "/api/customers/v2.0/vdcs/{vdc}/edges"
+	pathVDCGroupEdges     = "/api/customers/v2.0/vdc-groups/{vdcGroup}/edges"
+	pathEdges             = "/api/customers/v2.0/edges"
+	pathEdge              = "/api/customers/v2.0/edges/{edge}"
+	pathEdgeLoadBalancing = "/api/customers/v2.0/edges/{edge}/loadbalancing"
+	pathPublicIPs         = "/api/customers/v1.0/ip"
+	pathPublicIP          = "/api/customers/v1.0/ip/{ip}"
+	pathVCDAIPs           = "/api/customers/v1.0/vcda/ips"
+	pathVCDAIP            = "/api/customers/v1.0/vcda/ips/{ip}"
+	pathJob               = "/api/customers/v1.0/jobs/{job}"
+)
+
+const (
+	// headerPublicIPEdgeName is the header of the edge gateway of a new public IP.
+	headerPublicIPEdgeName = "X-VDC-EDGE-NAME"
+	// The public IPs are allocated from the documentation ranges.
+	publicIPsInternalIP     = "192.0.2.1"
+	publicIPsFirstUplinkIP  = 10
+	publicIPsUplinkIPPrefix = "203.0.113."
+)
+
+type (
+	// apiError is the error returned by the customer API.
+	apiError struct {
+		Code    string `json:"code"`
+		Reason  string `json:"reason"`
+		Message string `json:"message"`
+	}
+
+	jobCreated struct {
+		JobID string `json:"jobId"`
+	}
+
+	jobStatus struct {
+		Name        string             `json:"name"`
+		Description string             `json:"description"`
+		Status      string             `json:"status"`
+		Actions     []jobStatusActions `json:"actions"`
+	}
+
+	jobStatusActions struct {
+		Name    string `json:"name"`
+		Status  string `json:"status"`
+		Details string `json:"details"`
+	}
+
+	orgVDC struct {
+		VDCName string `json:"vdcName"`
+		VDCUUID string `json:"vdcUuid"`
+	}
+
+	edgeGateway struct {
+		Tier0VrfID  string `json:"tier0VrfId"`
+		EdgeName    string `json:"edgeName"`
+		EdgeID      string `json:"edgeId"`
+		OwnerType   string `json:"ownerType"`
+		OwnerName   string `json:"ownerName"`
+		Description string `json:"description"`
+	}
+
+	edgeGatewayCreate struct {
+		Tier0VrfID          string `json:"tier0VrfId"`
+		EnableLoadBalancing bool   `json:"enableLoadBalancing"`
+	}
+
+	edgeGatewayLoadBalancing struct {
+		Enabled bool `json:"enabled"`
+	}
+
+	publicIPs struct {
+		InternalIP    string            `json:"internalIp"`
+		NetworkConfig []publicIPNetwork `json:"networkConfig"`
+	}
+
+	publicIPNetwork struct {
+		UplinkIP        string `json:"uplinkIp"`
+		TranslatedIP    string `json:"translatedIp"`
+		EdgeGatewayName string `json:"edgeGatewayName"`
+	}
+)
+
+// customersAPI serves the Cloud Avenue customer API from memory.
+// The objects are created, updated or deleted when the request is received, and the
+// job returned is done after jobPendingPolls polls.
+type customersAPI struct {
+	mu sync.Mutex
+
+	// jobPendingPolls is the number of polls for which a job is in progress before being done.
+	jobPendingPolls int
+
+	routes []route
+
+	vdcs          map[string]json.RawMessage
+	vdcIDs        map[string]string
+	edges         map[string]*edgeGateway
+	loadBalancing map[string]bool
+	publicIPs     map[string]publicIPNetwork
+	vcdaIPs       map[string]struct{}
+	jobs          map[string]*jobStatus
+	jobPolls      map[string]int
+	nextUplinkIP  int
+}
+
+// route is an endpoint of the customer API. The segments of the pattern between braces are parameters.
+type route struct {
+	method  string
+	pattern string
+	handler func(w http.ResponseWriter, r *http.Request, params map[string]string)
+}
+
+func newCustomersAPI() *customersAPI {
+	a := &customersAPI{
+		vdcs:          make(map[string]json.RawMessage),
+		vdcIDs:        make(map[string]string),
+		edges:         make(map[string]*edgeGateway),
+		loadBalancing: make(map[string]bool),
+		publicIPs:     make(map[string]publicIPNetwork),
+		vcdaIPs:       make(map[string]struct{}),
+		jobs:          make(map[string]*jobStatus),
+		jobPolls:      make(map[string]int),
+		nextUplinkIP:  publicIPsFirstUplinkIP,
+	}
+
+	a.routes = []route{
+		{http.MethodGet, pathVDCs, a.listVDCs},
+		{http.MethodPost, pathVDCs, a.createVDC},
+		{http.MethodGet, pathVDC, a.getVDC},
+		{http.MethodPut, pathVDC, a.updateVDC},
+		{http.MethodDelete, pathVDC, a.deleteVDC},
+		{http.MethodPost, pathVDCEdges, a.createEdge("vdc", "vdc")},
+		{http.MethodPost, pathVDCGroupEdges, a.createEdge("vdc-group", "vdcGroup")},
+		{http.MethodGet, pathEdges, a.listEdges},
+		{http.MethodGet, pathEdge, a.getEdge},
+		{http.MethodDelete, pathEdge, a.deleteEdge},
+		{http.MethodGet, pathEdgeLoadBalancing, a.getEdgeLoadBalancing},
+		{http.MethodPut, pathEdgeLoadBalancing, a.updateEdgeLoadBalancing},
+		{http.MethodGet, pathPublicIPs, a.listPublicIPs},
+		{http.MethodPost, pathPublicIPs, a.createPublicIP},
+		{http.MethodDelete, pathPublicIP, a.deletePublicIP},
+		{http.MethodGet, pathVCDAIPs, a.listVCDAIPs},
+		{http.MethodPost, pathVCDAIP, a.createVCDAIP},
+		{http.MethodDelete, pathVCDAIP, a.deleteVCDAIP},
+		{http.MethodGet, pathJob, a.getJob},
+	}
+
+	return a
+}
+
+// ServeHTTP routes a request of the customer API.
+func (a *customersAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, rt := range a.routes {
+		if params, ok := matchPath(rt.pattern, r.URL.Path); ok && rt.method == r.Method {
+			rt.handler(w, r, params)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, fmt.Sprintf("mock: %s %s is not implemented", r.Method, r.URL.Path))
+}
+
+// matchPath matches a path against a pattern and returns the parameters of the pattern.
+func matchPath(pattern, path string) (map[string]string, bool) {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternSegments) != len(pathSegments) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, segment := range patternSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[strings.Trim(segment, "{}")] = pathSegments[i]
+			continue
+		}
+		if segment != pathSegments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+// newJob registers a job and writes its ID in the response.
+func (a *customersAPI) newJob(w http.ResponseWriter, name string) {
+	id := uuid.NewString()
+	a.jobs[id] = &jobStatus{
+		Name:        name,
+		Description: name,
+		Status:      jobStatusDone,
+		Actions: []jobStatusActions{
+			{Name: name, Status: jobStatusDone},
+		},
+	}
+
+	writeJSON(w, http.StatusCreated, jobCreated{JobID: id})
+}
+
+func (a *customersAPI) getJob(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	job, ok := a.jobs[params["job"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "job not found")
+		return
+	}
+
+	status := *job
+	if a.jobPolls[params["job"]] < a.jobPendingPolls {
+		a.jobPolls[params["job"]]++
+		status.Status = jobStatusInProgress
+	}
+
+	writeJSON(w, http.StatusOK, []jobStatus{status})
+}
+
+// * VDC
+
+func (a *customersAPI) listVDCs(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	vdcs := make([]orgVDC, 0, len(a.vdcs))
+	for _, name := range sortedKeys(a.vdcs) {
+		vdcs = append(vdcs, orgVDC{VDCName: name, VDCUUID: a.vdcIDs[name]})
+	}
+
+	writeJSON(w, http.StatusOK, vdcs)
+}
+
+func (a *customersAPI) createVDC(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	body, name, ok := readVDC(w, r)
+	if !ok {
+		return
+	}
+	if _, exists := a.vdcs[name]; exists {
+		writeError(w, http.StatusConflict, fmt.Sprintf("vdc %s already exists", name))
+		return
+	}
+
+	a.vdcs[name] = body
+	a.vdcIDs[name] = uuid.NewString()
+	a.newJob(w, "create vdc "+name)
+}
+
+func (a *customersAPI) getVDC(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	body, ok := a.vdcs[params["vdc"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("vdc %s not found", params["vdc"]))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, body)
+}
+
+func (a *customersAPI) updateVDC(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if _, ok := a.vdcs[params["vdc"]]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("vdc %s not found", params["vdc"]))
+		return
+	}
+
+	body, _, ok := readVDC(w, r)
+	if !ok {
+		return
+	}
+
+	a.vdcs[params["vdc"]] = body
+	a.newJob(w, "update vdc "+params["vdc"])
+}
+
+func (a *customersAPI) deleteVDC(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := a.vdcs[params["vdc"]]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("vdc %s not found", params["vdc"]))
+		return
+	}
+
+	delete(a.vdcs, params["vdc"])
+	delete(a.vdcIDs, params["vdc"])
+	a.newJob(w, "delete vdc "+params["vdc"])
+}
+
+// readVDC reads the body of a vDC creation or update and returns the name of the vDC.
+func readVDC(w http.ResponseWriter, r *http.Request) (body json.RawMessage, name string, ok bool) {
+	var vdc struct {
+		VDC struct {
+			Name string `json:"name"`
+		} `json:"vdc"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil, "", false
+	}
+	if err := json.Unmarshal(body, &vdc); err != nil || vdc.VDC.Name == "" {
+		writeError(w, http.StatusBadRequest, "vdc name is required")
+		return nil, "", false
+	}
+
+	return body, vdc.VDC.Name, true
+}
+
+// * Edge Gateway
+
+func (a *customersAPI) createEdge(ownerType, ownerParam string) func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		body := edgeGatewayCreate{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		edge := &edgeGateway{
+			Tier0VrfID: body.Tier0VrfID,
+			EdgeName:   fmt.Sprintf("tn01e02ocb0001234spt%03d", len(a.edges)+101),
+			EdgeID:     uuid.NewString(),
+			OwnerType:  ownerType,
+			OwnerName:  params[ownerParam],
+		}
+
+		a.edges[edge.EdgeID] = edge
+		a.loadBalancing[edge.EdgeID] = body.EnableLoadBalancing
+		a.newJob(w, "create edge gateway "+edge.EdgeName)
+	}
+}
+
+func (a *customersAPI) listEdges(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	edges := make([]edgeGateway, 0, len(a.edges))
+	for _, id := range sortedKeys(a.edges) {
+		edges = append(edges, *a.edges[id])
+	}
+
+	writeJSON(w, http.StatusOK, edges)
+}
+
+func (a *customersAPI) getEdge(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	edge, ok := a.edges[params["edge"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("edge gateway %s not found", params["edge"]))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, edge)
+}
+
+func (a *customersAPI) deleteEdge(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	edge, ok := a.edges[params["edge"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("edge gateway %s not found", params["edge"]))
+		return
+	}
+
+	delete(a.edges, params["edge"])
+	delete(a.loadBalancing, params["edge"])
+	a.newJob(w, "delete edge gateway "+edge.EdgeName)
+}
+
+func (a *customersAPI) getEdgeLoadBalancing(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := a.edges[params["edge"]]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("edge gateway %s not found", params["edge"]))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, edgeGatewayLoadBalancing{Enabled: a.loadBalancing[params["edge"]]})
+}
+
+func (a *customersAPI) updateEdgeLoadBalancing(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	edge, ok := a.edges[params["edge"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("edge gateway %s not found", params["edge"]))
+		return
+	}
+
+	body := edgeGatewayLoadBalancing{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	a.loadBalancing[params["edge"]] = body.Enabled
+	a.newJob(w, "update load balancing of edge gateway "+edge.EdgeName)
+}
+
+// * Public IP
+
+func (a *customersAPI) listPublicIPs(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	ips := publicIPs{
+		InternalIP:    publicIPsInternalIP,
+		NetworkConfig: make([]publicIPNetwork, 0, len(a.publicIPs)),
+	}
+	for _, ip := range sortedKeys(a.publicIPs) {
+		ips.NetworkConfig = append(ips.NetworkConfig, a.publicIPs[ip])
+	}
+
+	writeJSON(w, http.StatusOK, ips)
+}
+
+func (a *customersAPI) createPublicIP(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	edgeName := r.Header.Get(headerPublicIPEdgeName)
+	if edgeName == "" {
+		writeError(w, http.StatusBadRequest, headerPublicIPEdgeName+" header is required")
+		return
+	}
+
+	ip := fmt.Sprintf("%s%d", publicIPsUplinkIPPrefix, a.nextUplinkIP)
+	a.nextUplinkIP++
+
+	a.publicIPs[ip] = publicIPNetwork{
+		UplinkIP:        ip,
+		TranslatedIP:    publicIPsInternalIP,
+		EdgeGatewayName: edgeName,
+	}
+	a.newJob(w, "create public ip "+ip)
+}
+
+func (a *customersAPI) deletePublicIP(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := a.publicIPs[params["ip"]]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("public ip %s not found", params["ip"]))
+		return
+	}
+
+	delete(a.publicIPs, params["ip"])
+	a.newJob(w, "delete public ip "+params["ip"])
+}
+
+// * VCDA
+
+func (a *customersAPI) listVCDAIPs(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, sortedKeys(a.vcdaIPs))
+}
+
+func (a *customersAPI) createVCDAIP(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	a.vcdaIPs[params["ip"]] = struct{}{}
+	a.newJob(w, "create vcda ip "+params["ip"])
+}
+
+func (a *customersAPI) deleteVCDAIP(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := a.vcdaIPs[params["ip"]]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("vcda ip %s not found", params["ip"]))
+		return
+	}
+
+	delete(a.vcdaIPs, params["ip"])
+	a.newJob(w, "delete vcda ip "+params["ip"])
+}
+
+// sortedKeys returns the keys of a map in a stable order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, apiError{
+		Code:    fmt.Sprint(status),
+		Reason:  http.StatusText(status),
+		Message: message,
+	})
+}
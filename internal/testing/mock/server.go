@@ -0,0 +1,246 @@
+// Package mock provides an offline stand-in of the Cloud Avenue API for the acceptance tests.
+//
+// The Cloud Avenue customer API (vDC, edge gateways, public IPs, VCDA, jobs and token) is
+// served from memory. The VMware Cloud Director API is replayed from cassettes recorded
+// against a real Cloud Avenue organization.
+//
+// The mock is selected with the CLOUDAVENUE_MOCK environment variable:
+//   - replay: the tests run against the mock server, without network access. A test without
+//     cassette only gets the customer API and the organization, its other requests fail.
+//     The tests without cassette are skipped if CLOUDAVENUE_MOCK_SKIP_UNRECORDED is true.
+//   - record: the requests are forwarded to the real Cloud Avenue API (CLOUDAVENUE_URL)
+//     and recorded in the cassette of the test.
+package mock
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const (
+	// EnvMode is the environment variable selecting the mode of the mock server.
+	EnvMode = "CLOUDAVENUE_MOCK"
+	// EnvSkipUnrecorded is the environment variable skipping the tests without cassette in replay mode.
+	EnvSkipUnrecorded = "CLOUDAVENUE_MOCK_SKIP_UNRECORDED"
+)
+
+// Mode is the mode of the mock server.
+type Mode string
+
+const (
+	// ModeDisabled runs the acceptance tests against the real Cloud Avenue API.
+	ModeDisabled Mode = ""
+	// ModeReplay serves the customer API from memory and replays the cassette of the test.
+	ModeReplay Mode = "replay"
+	// ModeRecord forwards the requests to the real Cloud Avenue API and records them in the cassette of the test.
+	ModeRecord Mode = "record"
+)
+
+const (
+	// DefaultOrg is the organization served by the mock server when the test has no cassette.
+	DefaultOrg = "cav01ev01ocb0001234"
+	// DefaultVDC is the vDC served by the mock server when the test has no cassette.
+	DefaultVDC = "MOCK-VDC"
+)
+
+// Server is a mock of the Cloud Avenue API.
+type Server struct {
+	*httptest.Server
+
+	org       string
+	cassette  *cassette
+	customers *customersAPI
+
+	// target is the real Cloud Avenue API in record mode.
+	target *url.URL
+	proxy  *httputil.ReverseProxy
+}
+
+// NewServer starts a mock server serving the customer API from memory for the organization DefaultOrg.
+func NewServer() *Server {
+	return newReplayServer(newCassette(DefaultOrg, DefaultVDC))
+}
+
+// newReplayServer starts a mock server serving the customer API from memory and replaying the cassette c.
+func newReplayServer(c *cassette) *Server {
+	s := &Server{
+		org:       c.Org,
+		cassette:  c,
+		customers: newCustomersAPI(),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveReplay))
+
+	return s
+}
+
+// newRecordingServer starts a mock server forwarding the requests to target and recording them in the cassette c.
+func newRecordingServer(target *url.URL, c *cassette) *Server {
+	s := &Server{
+		org:      c.Org,
+		cassette: c,
+		target:   target,
+	}
+
+	s.proxy = &httputil.ReverseProxy{
+		Director:       s.forwardRequest,
+		ModifyResponse: s.recordResponse,
+	}
+	s.Server = httptest.NewServer(s.proxy)
+
+	return s
+}
+
+// Setup starts a mock server for the test t if CLOUDAVENUE_MOCK is set, and points the provider to it
+// through the CLOUDAVENUE_* environment variables. The cassette of the test is stored in
+// testdata/cassettes of the test package.
+func Setup(t *testing.T) {
+	t.Helper()
+
+	mode := Mode(os.Getenv(EnvMode))
+	path := CassettePath(t.Name())
+
+	switch mode {
+	case ModeDisabled:
+		return
+
+	case ModeReplay:
+		c, err := loadCassette(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			if skip, _ := strconv.ParseBool(os.Getenv(EnvSkipUnrecorded)); skip {
+				t.Skipf("no cassette %s, record it with %s=%s", path, EnvMode, ModeRecord)
+			}
+			// No cassette recorded, only the customer API and the organization are served.
+			c = newCassette(DefaultOrg, DefaultVDC)
+		case err != nil:
+			t.Fatalf("error loading the cassette %s: %s", path, err)
+		}
+
+		s := newReplayServer(c)
+		t.Cleanup(s.Close)
+
+		t.Setenv("CLOUDAVENUE_URL", s.URL)
+		t.Setenv("CLOUDAVENUE_ORG", c.Org)
+		t.Setenv("CLOUDAVENUE_VDC", c.VDC)
+		t.Setenv("CLOUDAVENUE_USER", "mock")
+		t.Setenv("CLOUDAVENUE_PASSWORD", "mock")
+		t.Setenv("CLOUDAVENUE_API_TOKEN", "")
+		t.Setenv("CLOUDAVENUE_TOKEN", "")
+
+	case ModeRecord:
+		target, err := url.Parse(os.Getenv("CLOUDAVENUE_URL"))
+		if err != nil || target.Host == "" {
+			t.Fatalf("CLOUDAVENUE_URL must be set to the real Cloud Avenue API to record the cassette of %s", t.Name())
+		}
+
+		c := newCassette(os.Getenv("CLOUDAVENUE_ORG"), os.Getenv("CLOUDAVENUE_VDC"))
+		s := newRecordingServer(target, c)
+		t.Cleanup(func() {
+			s.Close()
+			if err := c.save(path); err != nil {
+				t.Errorf("error saving the cassette %s: %s", path, err)
+			}
+		})
+
+		t.Setenv("CLOUDAVENUE_URL", s.URL)
+
+	default:
+		t.Fatalf("%s must be %q or %q, got %q", EnvMode, ModeReplay, ModeRecord, mode)
+	}
+}
+
+// CassettePath returns the path of the cassette of a test, relative to the test package.
+func CassettePath(testName string) string {
+	return filepath.Join("testdata", "cassettes", strings.ReplaceAll(testName, "/", "_")+".json")
+}
+
+// serveReplay serves a request in replay mode. The recorded interactions take precedence over
+// the customer API served from memory, so a recorded test is replayed as it was recorded.
+func (s *Server) serveReplay(w http.ResponseWriter, r *http.Request) {
+	if i := s.cassette.next(r.Method, r.URL.RequestURI()); i != nil {
+		i.write(w, s.URL)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, customersAPIPrefix):
+		s.customers.ServeHTTP(w, r)
+	case r.Method == http.MethodPost && r.URL.Path == sessionsPath:
+		s.serveSession(w, r)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/versions":
+		s.serveVersions(w)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/org":
+		s.serveOrgList(w)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/org/"+s.orgID():
+		s.serveOrg(w)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/admin/org/"+s.orgID():
+		s.serveAdminOrg(w)
+	default:
+		http.Error(w, fmt.Sprintf("mock: no recorded interaction for %s %s, record the cassette of the test with %s=%s", r.Method, r.URL.RequestURI(), EnvMode, ModeRecord), http.StatusNotImplemented)
+	}
+}
+
+// forwardRequest forwards a request to the real Cloud Avenue API in record mode.
+func (s *Server) forwardRequest(r *http.Request) {
+	r.URL.Scheme = s.target.Scheme
+	r.URL.Host = s.target.Host
+	r.Host = s.target.Host
+
+	// The recorded bodies must not be compressed.
+	r.Header.Del("Accept-Encoding")
+
+	// The requests refer to the objects through the URL of the mock server.
+	if r.Body != nil && r.Body != http.NoBody {
+		body, err := io.ReadAll(r.Body)
+		if err == nil {
+			body = bytes.ReplaceAll(body, []byte(s.URL), []byte(s.targetOrigin()))
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			r.ContentLength = int64(len(body))
+			r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+	}
+}
+
+// recordResponse records a response of the real Cloud Avenue API in record mode and
+// rewrites its URLs to the mock server.
+func (s *Server) recordResponse(resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return err
+	}
+
+	s.cassette.record(resp.Request.Method, resp.Request.URL.RequestURI(), resp.StatusCode, resp.Header, string(body), s.targetOrigin())
+
+	body = bytes.ReplaceAll(body, []byte(s.targetOrigin()), []byte(s.URL))
+	for _, key := range rewrittenHeaders {
+		for j, value := range resp.Header.Values(key) {
+			resp.Header[http.CanonicalHeaderKey(key)][j] = strings.ReplaceAll(value, s.targetOrigin(), s.URL)
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return nil
+}
+
+// targetOrigin returns the scheme and the host of the real Cloud Avenue API.
+func (s *Server) targetOrigin() string {
+	return s.target.Scheme + "://" + s.target.Host
+}
@@ -0,0 +1,263 @@
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func do(t *testing.T, method, url string, header map[string]string, body string) (*http.Response, string) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return resp, string(data)
+}
+
+func TestServerSession(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	resp, _ := do(t, http.MethodPost, s.URL+sessionsPath, nil, "")
+	if got := resp.Header.Get("X-Vmware-Vcloud-Access-Token"); got != mockToken {
+		t.Errorf("session token: got %q, want %q", got, mockToken)
+	}
+
+	_, body := do(t, http.MethodGet, s.URL+"/api/org", nil, "")
+	if !strings.Contains(body, `name="`+DefaultOrg+`"`) {
+		t.Errorf("org list: got %s, want the organization %s", body, DefaultOrg)
+	}
+}
+
+func TestServerCustomersAPI(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.SetJobPendingPolls(1)
+
+	// Create an edge gateway
+	resp, body := do(t, http.MethodPost, s.URL+"/api/customers/v2.0/vdcs/"+DefaultVDC+"/edges", nil, `{"tier0VrfId":"prvrf01eocb0001234allsp01","enableLoadBalancing":true}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create edge gateway: got status %d: %s", resp.StatusCode, body)
+	}
+
+	job := jobCreated{}
+	if err := json.Unmarshal([]byte(body), &job); err != nil || job.JobID == "" {
+		t.Fatalf("create edge gateway: invalid job %s: %v", body, err)
+	}
+
+	// The job is in progress for the first poll
+	for _, want := range []string{jobStatusInProgress, jobStatusDone} {
+		_, body = do(t, http.MethodGet, s.URL+"/api/customers/v1.0/jobs/"+job.JobID, nil, "")
+		status := []jobStatus{}
+		if err := json.Unmarshal([]byte(body), &status); err != nil || len(status) != 1 {
+			t.Fatalf("get job: invalid status %s: %v", body, err)
+		}
+		if status[0].Status != want {
+			t.Errorf("get job: got status %s, want %s", status[0].Status, want)
+		}
+	}
+
+	_, body = do(t, http.MethodGet, s.URL+"/api/customers/v2.0/edges", nil, "")
+	edges := []edgeGateway{}
+	if err := json.Unmarshal([]byte(body), &edges); err != nil || len(edges) != 1 {
+		t.Fatalf("list edge gateways: got %s: %v, want 1 edge gateway", body, err)
+	}
+	if edges[0].OwnerName != DefaultVDC || edges[0].OwnerType != "vdc" {
+		t.Errorf("list edge gateways: got owner %s %s, want vdc %s", edges[0].OwnerType, edges[0].OwnerName, DefaultVDC)
+	}
+
+	// Create and delete a public IP
+	do(t, http.MethodPost, s.URL+"/api/customers/v1.0/ip", map[string]string{headerPublicIPEdgeName: edges[0].EdgeName}, "")
+
+	_, body = do(t, http.MethodGet, s.URL+"/api/customers/v1.0/ip", nil, "")
+	ips := publicIPs{}
+	if err := json.Unmarshal([]byte(body), &ips); err != nil || len(ips.NetworkConfig) != 1 {
+		t.Fatalf("list public ips: got %s: %v, want 1 public ip", body, err)
+	}
+	if ips.NetworkConfig[0].EdgeGatewayName != edges[0].EdgeName {
+		t.Errorf("list public ips: got edge gateway %s, want %s", ips.NetworkConfig[0].EdgeGatewayName, edges[0].EdgeName)
+	}
+
+	if resp, _ = do(t, http.MethodDelete, s.URL+"/api/customers/v1.0/ip/"+ips.NetworkConfig[0].UplinkIP, nil, ""); resp.StatusCode != http.StatusCreated {
+		t.Errorf("delete public ip: got status %d", resp.StatusCode)
+	}
+	if resp, _ = do(t, http.MethodDelete, s.URL+"/api/customers/v1.0/ip/"+ips.NetworkConfig[0].UplinkIP, nil, ""); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("delete deleted public ip: got status %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestServerReplay(t *testing.T) {
+	c := newCassette("cav01ev01ocb0004321", "VDC-REPLAY")
+	c.Interactions = []*interaction{
+		{Method: http.MethodGet, URI: "/api/vApp/vapp-1", Status: http.StatusOK, Body: `<VApp href="` + urlPlaceholder + `/api/vApp/vapp-1" status="0"/>`},
+		{Method: http.MethodGet, URI: "/api/vApp/vapp-1", Status: http.StatusOK, Body: `<VApp href="` + urlPlaceholder + `/api/vApp/vapp-1" status="4"/>`},
+	}
+
+	s := newReplayServer(c)
+	defer s.Close()
+
+	// The interactions are replayed in order, then the last one is repeated
+	for _, status := range []string{"0", "4", "4"} {
+		_, body := do(t, http.MethodGet, s.URL+"/api/vApp/vapp-1", nil, "")
+		if want := fmt.Sprintf(`<VApp href="%s/api/vApp/vapp-1" status="%s"/>`, s.URL, status); body != want {
+			t.Errorf("replay: got %s, want %s", body, want)
+		}
+	}
+
+	if resp, _ := do(t, http.MethodGet, s.URL+"/api/vApp/vapp-2", nil, ""); resp.StatusCode != http.StatusNotImplemented {
+		t.Errorf("not recorded: got status %d, want %d", resp.StatusCode, http.StatusNotImplemented)
+	}
+}
+
+func TestServerRecord(t *testing.T) {
+	var (
+		upstream *httptest.Server
+		received string
+	)
+	upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.Header().Set("X-Vmware-Vcloud-Access-Token", "real-token")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"href":"%s/api/org/1","access_token":"real-token"}`, upstream.URL)
+	}))
+	defer upstream.Close()
+
+	target, err := url.Parse(upstream.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := newCassette(DefaultOrg, DefaultVDC)
+	s := newRecordingServer(target, c)
+	defer s.Close()
+
+	resp, body := do(t, http.MethodPost, s.URL+"/api/org/1?page=1", nil, `<Org href="`+s.URL+`/api/org/1"/>`)
+
+	// The client is answered with the URL of the mock server
+	if !strings.Contains(body, `"href":"`+s.URL+`/api/org/1"`) {
+		t.Errorf("record: got %s, want the URL of the mock server", body)
+	}
+	// The upstream receives the URL of the real API
+	if want := `<Org href="` + upstream.URL + `/api/org/1"/>`; received != want {
+		t.Errorf("record: upstream received %s, want %s", received, want)
+	}
+	if got := resp.Header.Get("X-Vmware-Vcloud-Access-Token"); got != "real-token" {
+		t.Errorf("record: got token %q, want the real token", got)
+	}
+
+	path := filepath.Join(t.TempDir(), CassettePath("TestServerRecord/step"))
+	if err := c.save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := loadCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.Interactions) != 1 {
+		t.Fatalf("record: got %d interactions, want 1", len(saved.Interactions))
+	}
+
+	i := saved.Interactions[0]
+	if i.Method != http.MethodPost || i.URI != "/api/org/1?page=1" {
+		t.Errorf("record: got request %s %s, want POST /api/org/1?page=1", i.Method, i.URI)
+	}
+	if strings.Contains(i.Body, upstream.URL) || !strings.Contains(i.Body, urlPlaceholder) {
+		t.Errorf("record: got body %s, want the URL of the real API replaced", i.Body)
+	}
+	if strings.Contains(i.Body, "real-token") || i.Header.Get("X-Vmware-Vcloud-Access-Token") != mockToken {
+		t.Errorf("record: got %s %v, want the tokens replaced", i.Body, i.Header)
+	}
+}
+
+func TestSetupReplayWithoutCassette(t *testing.T) {
+	t.Setenv(EnvMode, string(ModeReplay))
+
+	// The test runs against the customer API and the organization served from memory
+	skipped := false
+	t.Run("NotRecorded", func(t *testing.T) {
+		defer func() { skipped = t.Skipped() }()
+		Setup(t)
+
+		if got := os.Getenv("CLOUDAVENUE_ORG"); got != DefaultOrg {
+			t.Errorf("got organization %q, want %q", got, DefaultOrg)
+		}
+	})
+	if skipped {
+		t.Error("got the test without cassette skipped, want it run")
+	}
+
+	// The test is skipped on demand
+	t.Setenv(EnvSkipUnrecorded, "true")
+	t.Run("NotRecordedSkipped", func(t *testing.T) {
+		defer func() { skipped = t.Skipped() }()
+		Setup(t)
+	})
+	if !skipped {
+		t.Errorf("got the test without cassette run, want it skipped with %s", EnvSkipUnrecorded)
+	}
+}
+
+func TestServerOrg(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	vcdURL, err := url.Parse(s.URL + "/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The Vmware client of the provider connects and retrieves the organization
+	vcdClient := govcd.NewVCDClient(*vcdURL, true, govcd.WithAPIVersion("37.1"))
+	if err := vcdClient.SetToken(DefaultOrg, govcd.BearerTokenHeader, mockToken); err != nil {
+		t.Fatalf("set token: %s", err)
+	}
+
+	org, err := vcdClient.GetOrgByName(DefaultOrg)
+	if err != nil {
+		t.Fatalf("get org: %s", err)
+	}
+	if org.Org.Name != DefaultOrg {
+		t.Errorf("get org: got %q, want %q", org.Org.Name, DefaultOrg)
+	}
+
+	adminOrg, err := vcdClient.GetAdminOrgByName(DefaultOrg)
+	if err != nil {
+		t.Fatalf("get admin org: %s", err)
+	}
+	if adminOrg.AdminOrg.Name != DefaultOrg || adminOrg.TenantContext.OrgId == "" {
+		t.Errorf("get admin org: got %q (%q), want %q", adminOrg.AdminOrg.Name, adminOrg.TenantContext.OrgId, DefaultOrg)
+	}
+
+	if _, err := vcdClient.GetAdminOrgByName("unknown"); !govcd.ContainsNotFound(err) {
+		t.Errorf("get unknown admin org: got %v, want not found", err)
+	}
+}
@@ -0,0 +1,88 @@
+package mock
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// The vCD endpoints below are served when they are not recorded in the cassette, so that the
+// provider can be configured and can retrieve its organization, which is enough for the tests
+// of the customer API served from memory.
+
+// serveSession serves the session endpoint used to get a token with the user and password.
+func (s *Server) serveSession(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("X-Vmware-Vcloud-Access-Token", mockToken)
+	w.Header().Set("X-Vmware-Vcloud-Token-Type", "Bearer")
+	writeJSON(w, http.StatusOK, map[string]any{
+		"id":  "urn:vcloud:session:" + uuid.NewString(),
+		"org": map[string]string{"name": s.org},
+		"user": map[string]string{
+			"name": "mock",
+		},
+	})
+}
+
+// serveVersions serves the API versions supported by the mock server.
+func (s *Server) serveVersions(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/*+xml;version=37.1")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<SupportedVersions xmlns="http://www.vmware.com/vcloud/versions">
+    <VersionInfo deprecated="false">
+        <Version>37.1</Version>
+        <LoginUrl>%s/api/sessions</LoginUrl>
+    </VersionInfo>
+</SupportedVersions>
+`, s.URL)
+}
+
+// orgID returns the ID of the organization of the mock server.
+func (s *Server) orgID() string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(s.org)).String()
+}
+
+// serveOrgList serves the organization of the mock server.
+func (s *Server) serveOrgList(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/vnd.vmware.vcloud.orglist+xml;version=37.1")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<OrgList xmlns="http://www.vmware.com/vcloud/v1.5" href="%[1]s/api/org/" type="application/vnd.vmware.vcloud.orgList+xml">
+    <Org href="%[1]s/api/org/%[2]s" name="%[3]s" type="application/vnd.vmware.vcloud.org+xml"/>
+</OrgList>
+`, s.URL, s.orgID(), s.org)
+}
+
+// serveOrg serves the organization of the mock server, without any catalog, vDC or network.
+func (s *Server) serveOrg(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/vnd.vmware.vcloud.org+xml;version=37.1")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<Org xmlns="http://www.vmware.com/vcloud/v1.5" href="%[1]s/api/org/%[2]s" id="urn:vcloud:org:%[2]s" name="%[3]s" type="application/vnd.vmware.vcloud.org+xml">
+    <FullName>%[3]s</FullName>
+</Org>
+`, s.URL, s.orgID(), s.org)
+}
+
+// serveAdminOrg serves the organization of the mock server as admin, without any catalog, vDC or network.
+func (s *Server) serveAdminOrg(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/vnd.vmware.admin.organization+xml;version=37.1")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<AdminOrg xmlns="http://www.vmware.com/vcloud/v1.5" href="%[1]s/api/admin/org/%[2]s" id="urn:vcloud:org:%[2]s" name="%[3]s" type="application/vnd.vmware.admin.organization+xml">
+    <FullName>%[3]s</FullName>
+    <IsEnabled>true</IsEnabled>
+    <Settings/>
+</AdminOrg>
+`, s.URL, s.orgID(), s.org)
+}
+
+// SetJobPendingPolls sets the number of polls for which the jobs of the customer API are in
+// progress before being done. It allows to simulate long-running jobs.
+func (s *Server) SetJobPendingPolls(polls int) {
+	s.customers.mu.Lock()
+	defer s.customers.mu.Unlock()
+
+	s.customers.jobPendingPolls = polls
+}
@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+const testAccAlbVirtualServiceDataSourceConfig = `
+data "cloudavenue_alb_virtual_service" "example" {
+	edge_gateway_name = cloudavenue_alb_virtual_service.example.edge_gateway_name
+	name              = cloudavenue_alb_virtual_service.example.name
+}
+`
+
+func TestAccAlbVirtualServiceDataSource(t *testing.T) {
+	dataSourceName := "data.cloudavenue_alb_virtual_service.example"
+	resourceName := "cloudavenue_alb_virtual_service.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				// Apply test
+				Config: tests.ConcatTests(testAccAlbVirtualServicePoolConfig, testAccAlbVirtualServiceResourceConfig, testAccAlbVirtualServiceDataSourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "id", regexp.MustCompile(uuid.LoadBalancerVirtualService.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "virtual_ip", resourceName, "virtual_ip"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "application_profile_type", resourceName, "application_profile_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "pool_id", resourceName, "pool_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_engine_group_name", resourceName, "service_engine_group_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_ports.#", resourceName, "service_ports.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+const testAccAlbVirtualServicePoolConfig = `
+resource "cloudavenue_alb_pool" "example" {
+	edge_gateway_name = "tn01e02ocb0006205spt102"
+	name              = "ExampleVirtualService"
+
+	members = [
+	  {
+	    ip_address = "192.168.1.1"
+	    port       = "80"
+	  }
+	]
+  }
+`
+
+const testAccAlbVirtualServiceResourceConfig = `
+resource "cloudavenue_alb_virtual_service" "example" {
+	edge_gateway_name        = cloudavenue_alb_pool.example.edge_gateway_name
+	name                     = "example"
+	virtual_ip               = "192.168.10.10"
+	application_profile_type = "HTTP"
+	pool_name                = cloudavenue_alb_pool.example.name
+
+	service_ports = [
+	  {
+	    start = 80
+	  }
+	]
+  }
+`
+
+const testAccAlbVirtualServiceResourceConfigUpdate = `
+resource "cloudavenue_alb_virtual_service" "example" {
+	edge_gateway_name        = cloudavenue_alb_pool.example.edge_gateway_name
+	name                     = "example"
+	description              = "example updated"
+	enabled                  = false
+	virtual_ip               = "192.168.10.10"
+	application_profile_type = "L4"
+	pool_id                  = cloudavenue_alb_pool.example.id
+
+	service_ports = [
+	  {
+	    start = 80
+	  },
+	  {
+	    start = 8080
+	    end   = 8090
+	    type  = "TCP_FAST_PATH"
+	  }
+	]
+  }
+`
+
+func TestAccAlbVirtualServiceResource(t *testing.T) {
+	const resourceName = "cloudavenue_alb_virtual_service.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				// Apply test
+				Config: tests.ConcatTests(testAccAlbVirtualServicePoolConfig, testAccAlbVirtualServiceResourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(uuid.LoadBalancerVirtualService.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttr(resourceName, "name", "example"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "virtual_ip", "192.168.10.10"),
+					resource.TestCheckResourceAttr(resourceName, "application_profile_type", "HTTP"),
+					resource.TestCheckResourceAttrPair(resourceName, "pool_id", "cloudavenue_alb_pool.example", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_engine_group_name"),
+					resource.TestCheckResourceAttr(resourceName, "service_ports.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_ports.0.start", "80"),
+					resource.TestCheckResourceAttr(resourceName, "service_ports.0.end", "80"),
+					resource.TestCheckResourceAttr(resourceName, "service_ports.0.ssl_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "service_ports.0.type", "TCP_PROXY"),
+				),
+			},
+			{
+				// Update test
+				Config: tests.ConcatTests(testAccAlbVirtualServicePoolConfig, testAccAlbVirtualServiceResourceConfigUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(uuid.LoadBalancerVirtualService.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "example updated"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "application_profile_type", "L4"),
+					resource.TestCheckResourceAttrPair(resourceName, "pool_name", "cloudavenue_alb_pool.example", "name"),
+					resource.TestCheckResourceAttr(resourceName, "service_ports.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "service_ports.1.start", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "service_ports.1.end", "8090"),
+					resource.TestCheckResourceAttr(resourceName, "service_ports.1.type", "TCP_FAST_PATH"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "tn01e02ocb0006205spt102.example",
+			},
+		},
+	})
+}
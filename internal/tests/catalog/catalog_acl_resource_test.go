@@ -0,0 +1,83 @@
+// Package catalog provides the acceptance tests for the provider.
+package catalog
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+const testAccCatalogACLResourceConfig = `
+resource "cloudavenue_catalog" "test" {
+	name             = "test-catalog-acl"
+	description      = "catalog for acl"
+	delete_recursive = true
+	delete_force     = true
+}
+
+resource "cloudavenue_catalog_acl" "test" {
+	catalog_id            = cloudavenue_catalog.test.id
+	everyone_access_level = "ReadOnly"
+}
+`
+
+const testAccCatalogACLResourceSharedWithConfig = `
+resource "cloudavenue_catalog" "test" {
+	name             = "test-catalog-acl"
+	description      = "catalog for acl"
+	delete_recursive = true
+	delete_force     = true
+}
+
+resource "cloudavenue_catalog_acl" "test" {
+	catalog_name = cloudavenue_catalog.test.name
+	shared_with = [
+	{
+	  access_level = "ReadOnly"
+	  user_id      = "urn:vcloud:user:53665519-7036-43ea-ba97-63fc5a2aabe7"
+	}
+	]
+}
+`
+
+func TestAccCatalogACLResource(t *testing.T) {
+	const resourceName = "cloudavenue_catalog_acl.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Apply test
+				Config: testAccCatalogACLResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(uuid.Catalog.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttrPair(resourceName, "catalog_id", "cloudavenue_catalog.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_name", "test-catalog-acl"),
+					resource.TestCheckResourceAttr(resourceName, "everyone_access_level", "ReadOnly"),
+				),
+			},
+			{
+				// Update test
+				Config: testAccCatalogACLResourceSharedWithConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(uuid.Catalog.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttr(resourceName, "catalog_name", "test-catalog-acl"),
+					resource.TestCheckNoResourceAttr(resourceName, "everyone_access_level"),
+					resource.TestCheckResourceAttrSet(resourceName, "shared_with.0.subject_name"),
+				),
+			},
+			{
+				// Import test
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-catalog-acl",
+			},
+		},
+	})
+}
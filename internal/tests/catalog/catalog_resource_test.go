@@ -16,6 +16,17 @@ resource "cloudavenue_catalog" "test" {
 	description      = "catalog for files"
 	delete_recursive = true
 	delete_force     = true
+	metadata = [
+		{
+			key   = "env"
+			value = "dev"
+		},
+		{
+			key      = "owner"
+			value    = "team-cloud"
+			readonly = true
+		}
+	]
 }
 `
 
@@ -37,6 +48,19 @@ func TestAccCatalogResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttrSet(resourceName, "owner_name"),
 					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metadata.*", map[string]string{
+						"key":      "env",
+						"value":    "dev",
+						"type":     "STRING",
+						"domain":   "GENERAL",
+						"readonly": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metadata.*", map[string]string{
+						"key":      "owner",
+						"value":    "team-cloud",
+						"readonly": "true",
+					}),
 				),
 			},
 			{
@@ -49,6 +73,11 @@ func TestAccCatalogResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttrSet(resourceName, "owner_name"),
 					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metadata.*", map[string]string{
+						"key":   "env",
+						"value": "prod",
+					}),
 				),
 			},
 			// ImportState testing
@@ -65,5 +94,5 @@ func TestAccCatalogResource(t *testing.T) {
 }
 
 func testAccCatalogResourceUpdate() string {
-	return strings.Replace(testAccCatalogResourceConfig, "catalog for files", "catalog for ISO", 1)
+	return strings.NewReplacer("catalog for files", "catalog for ISO", `"dev"`, `"prod"`).Replace(testAccCatalogResourceConfig)
 }
@@ -0,0 +1,193 @@
+// Package catalog provides the acceptance tests for the provider.
+package catalog
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+)
+
+const testAccCatalogVappTemplateResourceConfig = `
+resource "cloudavenue_catalog" "test" {
+	name             = "test-catalog-vapp-template"
+	description      = "catalog for vApp Templates"
+	delete_recursive = true
+	delete_force     = true
+}
+
+resource "cloudavenue_catalog_vapp_template" "test" {
+	catalog_name  = cloudavenue_catalog.test.name
+	template_name = "photon-hw11"
+	description   = "Photon OS"
+	url           = "https://packages.vmware.com/photon/4.0/Rev2/ova/photon-hw11-4.0-c001795b80.ova"
+	checksum      = "c001795b80"
+}
+`
+
+const testAccCatalogVappTemplateResourceConfigUpdate = `
+resource "cloudavenue_catalog" "test" {
+	name             = "test-catalog-vapp-template"
+	description      = "catalog for vApp Templates"
+	delete_recursive = true
+	delete_force     = true
+}
+
+resource "cloudavenue_catalog_vapp_template" "test" {
+	catalog_name  = cloudavenue_catalog.test.name
+	template_name = "photon-hw11-updated"
+	description   = "Photon OS updated"
+	url           = "https://packages.vmware.com/photon/4.0/Rev2/ova/photon-hw11-4.0-c001795b80.ova"
+	checksum      = "c001795b80"
+}
+`
+
+const testAccCatalogVappTemplateResourceConfigDuplicate = testAccCatalogVappTemplateResourceConfig + `
+resource "cloudavenue_catalog_vapp_template" "duplicate" {
+	catalog_name  = cloudavenue_catalog.test.name
+	template_name = cloudavenue_catalog_vapp_template.test.template_name
+	url           = "https://packages.vmware.com/photon/4.0/Rev2/ova/photon-hw11-4.0-c001795b80.ova"
+}
+`
+
+func TestAccCatalogVappTemplateResource(t *testing.T) {
+	resourceName := "cloudavenue_catalog_vapp_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCatalogVappTemplateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_name", "test-catalog-vapp-template"),
+					resource.TestCheckResourceAttr(resourceName, "template_name", "photon-hw11"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Photon OS"),
+					resource.TestCheckResourceAttr(resourceName, "checksum", "c001795b80"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "vm_names.#"),
+				),
+			},
+			// The upload of a vApp Template with the same name fails
+			{
+				Config:      testAccCatalogVappTemplateResourceConfigDuplicate,
+				ExpectError: regexp.MustCompile(`already exists`),
+			},
+			// The existing vApp Template is kept
+			{
+				Config:   testAccCatalogVappTemplateResourceConfig,
+				PlanOnly: true,
+			},
+			// Update testing
+			{
+				Config: testAccCatalogVappTemplateResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "template_name", "photon-hw11-updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Photon OS updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           "test-catalog-vapp-template.photon-hw11-updated",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"url", "checksum", "timeouts"},
+			},
+		},
+	})
+}
+
+const testAccCatalogVappTemplateResourceCaptureConfig = `
+resource "cloudavenue_catalog" "test" {
+	name             = "test-catalog-vapp-template-capture"
+	description      = "catalog for captured vApp Templates"
+	delete_recursive = true
+	delete_force     = true
+}
+
+resource "cloudavenue_vapp" "test" {
+	name        = "test-vapp-template-capture"
+	description = "vApp captured as a vApp Template"
+}
+
+resource "cloudavenue_catalog_vapp_template" "test" {
+	catalog_id               = cloudavenue_catalog.test.id
+	template_name            = "captured-vapp"
+	description              = "Captured vApp"
+	vapp_name                = cloudavenue_vapp.test.name
+	customize_on_instantiate = true
+}
+`
+
+const testAccCatalogVappTemplateResourceCaptureConfigOverwrite = `
+resource "cloudavenue_catalog" "test" {
+	name             = "test-catalog-vapp-template-capture"
+	description      = "catalog for captured vApp Templates"
+	delete_recursive = true
+	delete_force     = true
+}
+
+resource "cloudavenue_vapp" "test" {
+	name        = "test-vapp-template-capture"
+	description = "vApp captured as a vApp Template"
+}
+
+resource "cloudavenue_catalog_vapp_template" "test" {
+	catalog_id               = cloudavenue_catalog.test.id
+	template_name            = "captured-vapp"
+	description              = "Captured vApp"
+	vapp_id                  = cloudavenue_vapp.test.id
+	customize_on_instantiate = true
+	overwrite                = true
+}
+`
+
+func TestAccCatalogVappTemplateResourceCapture(t *testing.T) {
+	resourceName := "cloudavenue_catalog_vapp_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCatalogVappTemplateResourceCaptureConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_name", "test-catalog-vapp-template-capture"),
+					resource.TestCheckResourceAttr(resourceName, "template_name", "captured-vapp"),
+					resource.TestCheckResourceAttr(resourceName, "vapp_name", "test-vapp-template-capture"),
+					resource.TestCheckResourceAttr(resourceName, "customize_on_instantiate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "overwrite", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "checksum"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			// Capture the vApp by its ID with the overwrite option
+			{
+				Config: testAccCatalogVappTemplateResourceCaptureConfigOverwrite,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "template_name", "captured-vapp"),
+					resource.TestCheckResourceAttrPair(resourceName, "vapp_id", "cloudavenue_vapp.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "customize_on_instantiate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "overwrite", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           "test-catalog-vapp-template-capture.captured-vapp",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"vapp_id", "overwrite", "timeouts"},
+			},
+		},
+	})
+}
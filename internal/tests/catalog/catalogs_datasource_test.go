@@ -13,6 +13,28 @@ const testAccCatalogsDataSourceConfig = `
 data "cloudavenue_catalogs" "test" {}
 `
 
+const testAccCatalogsDataSourceMetadataFilterConfig = `
+resource "cloudavenue_catalog" "test" {
+	name             = "test-catalog-metadata"
+	description      = "catalog with metadata"
+	delete_recursive = true
+	delete_force     = true
+	metadata = [
+		{
+			key   = "env"
+			value = "test-metadata-filter"
+		}
+	]
+}
+
+data "cloudavenue_catalogs" "test" {
+	metadata_filter = {
+		env = "test-metadata-filter"
+	}
+	depends_on = [cloudavenue_catalog.test]
+}
+`
+
 func TestAccCatalogsDataSource(t *testing.T) {
 	dataSourceName := "data.cloudavenue_catalogs.test"
 
@@ -29,6 +51,14 @@ func TestAccCatalogsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
 				),
 			},
+			{
+				Config: testAccCatalogsDataSourceMetadataFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "catalogs.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "catalogs_name.0", "test-catalog-metadata"),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata_filter.env", "test-metadata-filter"),
+				),
+			},
 		},
 	})
 }
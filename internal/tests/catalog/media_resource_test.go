@@ -0,0 +1,109 @@
+// Package catalog provides the acceptance tests for the provider.
+package catalog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+)
+
+const testAccCatalogMediaResourceConfig = `
+resource "cloudavenue_catalog" "test" {
+	name             = "test-catalog-media"
+	description      = "catalog for medias"
+	delete_recursive = true
+	delete_force     = true
+}
+
+resource "cloudavenue_catalog_media" "test" {
+	catalog_name = cloudavenue_catalog.test.name
+	name         = "test-media.iso"
+	description  = "%s"
+	file_path    = "%s"
+}
+`
+
+const testAccCatalogMediaResourceConfigDuplicate = testAccCatalogMediaResourceConfig + `
+resource "cloudavenue_catalog_media" "duplicate" {
+	catalog_name = cloudavenue_catalog.test.name
+	name         = cloudavenue_catalog_media.test.name
+	file_path    = "%s"
+}
+`
+
+// writeTestISO writes a minimal file recognized as an ISO image (CD001 signature at offset 0x8001).
+func writeTestISO(t *testing.T, content string) string {
+	t.Helper()
+
+	data := make([]byte, 0x9000)
+	copy(data[0x8001:], "CD001")
+	copy(data[0x8800:], content)
+
+	filePath := filepath.Join(t.TempDir(), "test-media.iso")
+	if err := os.WriteFile(filePath, data, 0o600); err != nil {
+		t.Fatalf("unable to write the ISO file: %v", err)
+	}
+
+	return filePath
+}
+
+func TestAccCatalogMediaResource(t *testing.T) {
+	resourceName := "cloudavenue_catalog_media.test"
+	isoV1 := writeTestISO(t, "v1")
+	isoV2 := writeTestISO(t, "v2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(testAccCatalogMediaResourceConfig, "media v1", isoV1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_name", "test-catalog-media"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test-media.iso"),
+					resource.TestCheckResourceAttr(resourceName, "description", "media v1"),
+					resource.TestCheckResourceAttr(resourceName, "is_iso", "true"),
+					resource.TestCheckResourceAttr(resourceName, "force", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "size"),
+				),
+			},
+			// The upload of a media with the same name fails
+			{
+				Config:      fmt.Sprintf(testAccCatalogMediaResourceConfigDuplicate, "media v1", isoV1, isoV2),
+				ExpectError: regexp.MustCompile(`already exists`),
+			},
+			// The existing media is kept
+			{
+				Config:   fmt.Sprintf(testAccCatalogMediaResourceConfig, "media v1", isoV1),
+				PlanOnly: true,
+			},
+			// The content of the file changes, the media is replaced
+			{
+				Config: fmt.Sprintf(testAccCatalogMediaResourceConfig, "media v2", isoV2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "media v2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           "test-catalog-media.test-media.iso",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file_path", "checksum", "timeouts"},
+			},
+		},
+	})
+}
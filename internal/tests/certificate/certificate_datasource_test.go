@@ -0,0 +1,43 @@
+package certificate
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+)
+
+const testAccCertificateDataSourceConfig = `
+data "cloudavenue_certificate" "example" {
+  alias = cloudavenue_certificate.example.alias
+}
+`
+
+func TestAccCertificateDataSource(t *testing.T) {
+	const (
+		dataSourceName = "data.cloudavenue_certificate.example"
+		resourceName   = "cloudavenue_certificate.example"
+	)
+	certificatePath, privateKeyPath := writeTestCertificate(t, 365*24*time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Read test
+				Config: fmt.Sprintf(testAccCertificateResourceConfig, "example-certificate-ds", certificatePath, privateKeyPath) + testAccCertificateDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "expiry_date", resourceName, "expiry_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fingerprint", resourceName, "fingerprint"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "certificate"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,114 @@
+// Package certificate provides the acceptance tests for the provider.
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+const testAccCertificateResourceConfig = `
+resource "cloudavenue_certificate" "example" {
+  alias       = "%s"
+  description = "This is an example certificate"
+  certificate = file("%s")
+  private_key = file("%s")
+}
+`
+
+// writeTestCertificate writes a self-signed certificate and its private key valid for the given duration.
+func writeTestCertificate(t *testing.T, validity time.Duration) (certificatePath, privateKeyPath string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate the private key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create the certificate: %v", err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal the private key: %v", err)
+	}
+
+	dir := t.TempDir()
+	certificatePath = filepath.Join(dir, "cert.pem")
+	privateKeyPath = filepath.Join(dir, "key.pem")
+
+	if err := os.WriteFile(certificatePath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("unable to write the certificate: %v", err)
+	}
+	if err := os.WriteFile(privateKeyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600); err != nil {
+		t.Fatalf("unable to write the private key: %v", err)
+	}
+
+	return certificatePath, privateKeyPath
+}
+
+func TestAccCertificateResource(t *testing.T) {
+	const resourceName = "cloudavenue_certificate.example"
+	certificatePath, privateKeyPath := writeTestCertificate(t, 365*24*time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Apply test
+				Config: fmt.Sprintf(testAccCertificateResourceConfig, "example-certificate", certificatePath, privateKeyPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(uuid.Certificate.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttr(resourceName, "alias", "example-certificate"),
+					resource.TestCheckResourceAttr(resourceName, "description", "This is an example certificate"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_warning_days", "30"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiry_date"),
+					resource.TestMatchResourceAttr(resourceName, "fingerprint", regexp.MustCompile(`^([0-9A-F]{2}:){31}[0-9A-F]{2}$`)),
+				),
+			},
+			{
+				// Update test
+				Config: fmt.Sprintf(testAccCertificateResourceConfig, "example-certificate-updated", certificatePath, privateKeyPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "alias", "example-certificate-updated"),
+				),
+			},
+			{
+				// Import test
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           "example-certificate-updated",
+				ImportStateVerifyIgnore: []string{"private_key", "private_key_passphrase"},
+			},
+		},
+	})
+}
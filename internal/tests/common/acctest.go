@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/testing/mock"
 )
 
 // TestAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -24,16 +25,22 @@ var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 // about the appropriate environment variables being set are common to see in a pre-check
 // function.
 func TestAccPreCheck(t *testing.T) {
+	// Run the test against the mock server if CLOUDAVENUE_MOCK is set.
+	mock.Setup(t)
+
 	if v := os.Getenv("CLOUDAVENUE_URL"); v == "" {
 		t.Fatal("CLOUDAVENUE_URL must be set for acceptance tests")
 	}
 
-	if v := os.Getenv("CLOUDAVENUE_USER"); v == "" {
-		t.Fatal("CLOUDAVENUE_USER must be set for acceptance tests")
-	}
+	// The API token or the token can be used instead of the user and password.
+	if os.Getenv("CLOUDAVENUE_API_TOKEN") == "" && os.Getenv("CLOUDAVENUE_TOKEN") == "" {
+		if v := os.Getenv("CLOUDAVENUE_USER"); v == "" {
+			t.Fatal("CLOUDAVENUE_USER must be set for acceptance tests")
+		}
 
-	if v := os.Getenv("CLOUDAVENUE_PASSWORD"); v == "" {
-		t.Fatal("CLOUDAVENUE_PASSWORD must be set for acceptance tests")
+		if v := os.Getenv("CLOUDAVENUE_PASSWORD"); v == "" {
+			t.Fatal("CLOUDAVENUE_PASSWORD must be set for acceptance tests")
+		}
 	}
 
 	if v := os.Getenv("CLOUDAVENUE_ORG"); v == "" {
@@ -0,0 +1,69 @@
+// Package disk provides the acceptance tests for the provider.
+package disk
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+//go:generate tf-doc-extractor -filename $GOFILE -example-dir ../../../examples -test
+const testAccDiskResourceConfig = `
+resource "cloudavenue_disk" "example" {
+  name        = "disk-example"
+  description = "This is an example independent disk"
+  size_in_mb  = 2048
+  bus_type    = "SATA"
+}
+`
+
+const testAccDiskResourceConfigUpdate = `
+resource "cloudavenue_disk" "example" {
+  name       = "disk-example-updated"
+  size_in_mb = 4096
+  bus_type   = "SATA"
+}
+`
+
+func TestAccDiskResource(t *testing.T) {
+	const resourceName = "cloudavenue_disk.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Apply test
+				Config: testAccDiskResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(uuid.Disk.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttrSet(resourceName, "vdc"),
+					resource.TestCheckResourceAttr(resourceName, "name", "disk-example"),
+					resource.TestCheckResourceAttr(resourceName, "description", "This is an example independent disk"),
+					resource.TestCheckResourceAttr(resourceName, "size_in_mb", "2048"),
+					resource.TestCheckResourceAttr(resourceName, "bus_type", "SATA"),
+					resource.TestCheckResourceAttr(resourceName, "sharing_type", "None"),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_profile"),
+				),
+			},
+			{
+				// Update test
+				Config: testAccDiskResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "disk-example-updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "size_in_mb", "4096"),
+				),
+			},
+			{
+				// Import test
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,39 @@
+package disk
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+)
+
+//go:generate tf-doc-extractor -filename $GOFILE -example-dir ../../../examples -test
+const testAccDisksDataSourceConfig = `
+data "cloudavenue_disks" "example" {
+}
+`
+
+func TestAccDisksDataSource(t *testing.T) {
+	const dataSourceName = "data.cloudavenue_disks.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Read test
+				Config: testAccDiskResourceConfig + testAccDisksDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "id", regexp.MustCompile(`([a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12})`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vdc"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "disks.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "disks.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "disks.0.size_in_mb"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "disks.0.bus_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "disks.0.is_attached"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package edgegw
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+)
+
+//go:generate go run github.com/FrangipaneTeam/tf-doc-extractor@latest -filename $GOFILE -example-dir ../../../examples -test
+const testAccFirewallRuleResourceConfig = `
+data "cloudavenue_edgegateways" "example" {}
+
+resource "cloudavenue_edgegateway_firewall_rule" "example" {
+  edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+  name            = "allow IN IPv4 traffic"
+  action          = "ALLOW"
+  direction       = "IN"
+  ip_protocol     = "IPV4"
+}
+
+resource "cloudavenue_edgegateway_firewall_rule" "example_before" {
+  edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+  name            = "drop OUT IPv4 traffic"
+  action          = "DROP"
+  direction       = "OUT"
+  before_rule_id  = cloudavenue_edgegateway_firewall_rule.example.id
+}
+`
+
+const testAccFirewallRuleResourceConfigUpdate = `
+data "cloudavenue_edgegateways" "example" {}
+
+resource "cloudavenue_edgegateway_firewall_rule" "example" {
+  edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+  name            = "allow IN IPv4 traffic"
+  action          = "ALLOW"
+  direction       = "IN_OUT"
+  ip_protocol     = "IPV4"
+  logging         = true
+}
+
+resource "cloudavenue_edgegateway_firewall_rule" "example_before" {
+  edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+  name            = "drop OUT IPv4 traffic"
+  action          = "DROP"
+  direction       = "OUT"
+  after_rule_id   = cloudavenue_edgegateway_firewall_rule.example.id
+}
+`
+
+func TestAccFirewallRuleResource(t *testing.T) {
+	const (
+		resourceName       = "cloudavenue_edgegateway_firewall_rule.example"
+		resourceNameBefore = "cloudavenue_edgegateway_firewall_rule.example_before"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Apply test
+				Config: testAccFirewallRuleResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttrSet(resourceName, "edge_gateway_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "edge_gateway_name"),
+					resource.TestCheckResourceAttr(resourceName, "name", "allow IN IPv4 traffic"),
+					resource.TestCheckResourceAttr(resourceName, "action", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "direction", "IN"),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "IPV4"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging", "false"),
+					resource.TestCheckResourceAttrPair(resourceNameBefore, "before_rule_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceNameBefore, "action", "DROP"),
+				),
+			},
+			{
+				// Update test
+				Config: testAccFirewallRuleResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "direction", "IN_OUT"),
+					resource.TestCheckResourceAttr(resourceName, "logging", "true"),
+					resource.TestCheckNoResourceAttr(resourceNameBefore, "before_rule_id"),
+					resource.TestCheckResourceAttrPair(resourceNameBefore, "after_rule_id", resourceName, "id"),
+				),
+			},
+			{
+				// Import test
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccFirewallRuleResourceImportStateIDFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccFirewallRuleResourceImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s.%s", rs.Primary.Attributes["edge_gateway_name"], rs.Primary.ID), nil
+	}
+}
@@ -0,0 +1,162 @@
+package edgegw
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+const testAccNATRuleResourceConfig = `
+resource "cloudavenue_publicip" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+}
+
+resource "cloudavenue_edgegateway_nat_rule" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+	name = "example-snat"
+	rule_type = "SNAT"
+	external_address = cloudavenue_publicip.example.public_ip
+	internal_address = "11.11.11.0/24"
+}
+`
+
+const testAccNATRuleResourceConfigUpdate = `
+resource "cloudavenue_publicip" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+}
+
+resource "cloudavenue_edgegateway_nat_rule" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+	name = "example-snat"
+	description = "example description"
+	rule_type = "SNAT"
+	external_address = cloudavenue_publicip.example.public_ip
+	internal_address = "11.11.12.0/24"
+	snat_destination_address = "8.8.8.8"
+	firewall_match = "MATCH_EXTERNAL_ADDRESS"
+	priority = 10
+}
+`
+
+const testAccNATRuleResourceConfigDNAT = `
+resource "cloudavenue_publicip" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+}
+
+resource "cloudavenue_edgegateway_nat_rule" "example_dnat" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+	name = "example-dnat"
+	rule_type = "DNAT"
+	external_address = cloudavenue_publicip.example.public_ip
+	internal_address = "11.11.11.4"
+	dnat_external_port = "8080"
+}
+`
+
+func natRuleTestCheck(resourceName string) resource.TestCheckFunc {
+	return resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet(resourceName, "id"),
+		resource.TestCheckResourceAttrWith(resourceName, "edge_gateway_id", uuid.TestIsType(uuid.Gateway)),
+		resource.TestCheckResourceAttr(resourceName, "name", "example-snat"),
+		resource.TestCheckNoResourceAttr(resourceName, "description"),
+		resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+		resource.TestCheckResourceAttr(resourceName, "rule_type", "SNAT"),
+		resource.TestCheckResourceAttrSet(resourceName, "external_address"),
+		resource.TestCheckResourceAttr(resourceName, "internal_address", "11.11.11.0/24"),
+		resource.TestCheckResourceAttr(resourceName, "firewall_match", "MATCH_INTERNAL_ADDRESS"),
+		resource.TestCheckResourceAttr(resourceName, "priority", "0"),
+	)
+}
+
+func natRuleTestCheckUpdated(resourceName string) resource.TestCheckFunc {
+	return resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet(resourceName, "id"),
+		resource.TestCheckResourceAttrWith(resourceName, "edge_gateway_id", uuid.TestIsType(uuid.Gateway)),
+		resource.TestCheckResourceAttr(resourceName, "name", "example-snat"),
+		resource.TestCheckResourceAttr(resourceName, "description", "example description"),
+		resource.TestCheckResourceAttr(resourceName, "rule_type", "SNAT"),
+		resource.TestCheckResourceAttr(resourceName, "internal_address", "11.11.12.0/24"),
+		resource.TestCheckResourceAttr(resourceName, "snat_destination_address", "8.8.8.8"),
+		resource.TestCheckResourceAttr(resourceName, "firewall_match", "MATCH_EXTERNAL_ADDRESS"),
+		resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+	)
+}
+
+func TestAccNATRuleResource(t *testing.T) {
+	resourceName := "cloudavenue_edgegateway_nat_rule.example"
+	resourceNameDNAT := "cloudavenue_edgegateway_nat_rule.example_dnat"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// * Test SNAT
+			{
+				// Apply test
+				Config: tests.ConcatTests(testAccEdgeGatewayResourceConfig, testAccNATRuleResourceConfig),
+				Check:  natRuleTestCheck(resourceName),
+			},
+			// Update testing
+			{
+				// Update test
+				Config: tests.ConcatTests(testAccEdgeGatewayResourceConfig, testAccNATRuleResourceConfigUpdate),
+				Check:  natRuleTestCheckUpdated(resourceName),
+			},
+			// Import State testing
+			{
+				// Import test with ID
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNATRuleResourceImportStateIDFuncWithID(resourceName),
+			},
+			{
+				// Import test with Name
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNATRuleResourceImportStateIDFuncWithName(resourceName),
+			},
+			// * Test DNAT
+			{
+				// Apply test
+				Config: tests.ConcatTests(testAccEdgeGatewayResourceConfig, testAccNATRuleResourceConfigDNAT),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameDNAT, "id"),
+					resource.TestCheckResourceAttr(resourceNameDNAT, "name", "example-dnat"),
+					resource.TestCheckResourceAttr(resourceNameDNAT, "rule_type", "DNAT"),
+					resource.TestCheckResourceAttrSet(resourceNameDNAT, "external_address"),
+					resource.TestCheckResourceAttr(resourceNameDNAT, "internal_address", "11.11.11.4"),
+					resource.TestCheckResourceAttr(resourceNameDNAT, "dnat_external_port", "8080"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNATRuleResourceImportStateIDFuncWithID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s.%s", rs.Primary.Attributes["edge_gateway_id"], rs.Primary.Attributes["id"]), nil
+	}
+}
+
+func testAccNATRuleResourceImportStateIDFuncWithName(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s.%s", rs.Primary.Attributes["edge_gateway_name"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -2,6 +2,8 @@ package edgegw
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -180,3 +182,43 @@ func testAccStaticRouteResourceImportStateIDFuncWithName(resourceName string) re
 		return fmt.Sprintf("%s.%s", rs.Primary.Attributes["edge_gateway_name"], rs.Primary.Attributes["name"]), nil
 	}
 }
+
+const testAccStaticRouteResourceConfigIPv6 = `
+resource "cloudavenue_edgegateway_static_route" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+	name = "example"
+	network_cidr = "2001:db8:1::/64"
+	next_hops = [
+		{
+			ip_address = "2001:db8:ffff::1"
+		}
+	]
+}
+`
+
+func TestAccStaticRouteResourceIPv6(t *testing.T) {
+	resourceName := "cloudavenue_edgegateway_static_route.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// IPv4 next hop for an IPv6 network is rejected
+			{
+				Config:      tests.ConcatTests(testAccEdgeGatewayResourceConfig, strings.Replace(testAccStaticRouteResourceConfigIPv6, "2001:db8:ffff::1", "192.168.1.254", 1)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Mixed IP families`),
+			},
+			{
+				// Apply test
+				Config: tests.ConcatTests(testAccEdgeGatewayResourceConfig, testAccStaticRouteResourceConfigIPv6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "network_cidr", "2001:db8:1::/64"),
+					resource.TestCheckResourceAttr(resourceName, "next_hops.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "next_hops.0.ip_address", "2001:db8:ffff::1"),
+				),
+			},
+		},
+	})
+}
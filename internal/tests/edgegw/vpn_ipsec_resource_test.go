@@ -0,0 +1,148 @@
+package edgegw
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+const testAccVPNIPSecResourceConfig = `
+resource "cloudavenue_publicip" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+}
+
+resource "cloudavenue_edgegateway_vpn_ipsec" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+	name = "example"
+	pre_shared_key = "my-secret-psk"
+
+	local_ip_address = cloudavenue_publicip.example.public_ip
+	local_networks = ["10.10.10.0/24"]
+
+	remote_ip_address = "1.2.3.4"
+	remote_networks = ["192.168.1.0/24"]
+}
+`
+
+const testAccVPNIPSecResourceConfigUpdate = `
+resource "cloudavenue_publicip" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+}
+
+resource "cloudavenue_edgegateway_vpn_ipsec" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+	name = "example"
+	description = "example description"
+	pre_shared_key = "my-secret-psk-updated"
+
+	local_ip_address = cloudavenue_publicip.example.public_ip
+	local_networks = ["10.10.10.0/24", "10.10.20.0/24"]
+
+	remote_ip_address = "1.2.3.4"
+	remote_networks = ["192.168.1.0/24"]
+
+	security_profile = {
+		ike_version              = "IKE_V2"
+		ike_encryption_algorithm = "AES_256"
+		ike_digest_algorithm     = "SHA2_512"
+		ike_dh_group             = "GROUP15"
+		ike_sa_lifetime          = 86400
+
+		tunnel_pfs_enabled          = true
+		tunnel_df_policy            = "COPY"
+		tunnel_encryption_algorithm = "AES_256"
+		tunnel_digest_algorithm     = "SHA2_512"
+		tunnel_dh_group             = "GROUP15"
+		tunnel_sa_lifetime          = 3600
+
+		dpd_probe_interval = 30
+	}
+}
+`
+
+func vpnIPSecTestCheck(resourceName string) resource.TestCheckFunc {
+	return resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet(resourceName, "id"),
+		resource.TestCheckResourceAttrWith(resourceName, "edge_gateway_id", uuid.TestIsType(uuid.Gateway)),
+		resource.TestCheckResourceAttr(resourceName, "name", "example"),
+		resource.TestCheckNoResourceAttr(resourceName, "description"),
+		resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+		resource.TestCheckResourceAttr(resourceName, "pre_shared_key", "my-secret-psk"),
+		resource.TestCheckResourceAttrSet(resourceName, "local_ip_address"),
+		resource.TestCheckResourceAttr(resourceName, "local_networks.#", "1"),
+		resource.TestCheckResourceAttr(resourceName, "remote_ip_address", "1.2.3.4"),
+		resource.TestCheckResourceAttr(resourceName, "remote_networks.#", "1"),
+		resource.TestCheckNoResourceAttr(resourceName, "security_profile.ike_version"),
+	)
+}
+
+func vpnIPSecTestCheckUpdated(resourceName string) resource.TestCheckFunc {
+	return resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet(resourceName, "id"),
+		resource.TestCheckResourceAttrWith(resourceName, "edge_gateway_id", uuid.TestIsType(uuid.Gateway)),
+		resource.TestCheckResourceAttr(resourceName, "name", "example"),
+		resource.TestCheckResourceAttr(resourceName, "description", "example description"),
+		resource.TestCheckResourceAttr(resourceName, "pre_shared_key", "my-secret-psk-updated"),
+		resource.TestCheckResourceAttr(resourceName, "local_networks.#", "2"),
+		resource.TestCheckResourceAttr(resourceName, "security_profile.ike_version", "IKE_V2"),
+		resource.TestCheckResourceAttr(resourceName, "security_profile.ike_encryption_algorithm", "AES_256"),
+		resource.TestCheckResourceAttr(resourceName, "security_profile.ike_digest_algorithm", "SHA2_512"),
+		resource.TestCheckResourceAttr(resourceName, "security_profile.ike_dh_group", "GROUP15"),
+		resource.TestCheckResourceAttr(resourceName, "security_profile.tunnel_encryption_algorithm", "AES_256"),
+		resource.TestCheckResourceAttr(resourceName, "security_profile.tunnel_digest_algorithm", "SHA2_512"),
+		resource.TestCheckResourceAttr(resourceName, "security_profile.dpd_probe_interval", "30"),
+	)
+}
+
+func TestAccVPNIPSecResource(t *testing.T) {
+	resourceName := "cloudavenue_edgegateway_vpn_ipsec.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				// Apply test
+				Config: tests.ConcatTests(testAccEdgeGatewayResourceConfig, testAccVPNIPSecResourceConfig),
+				Check:  vpnIPSecTestCheck(resourceName),
+			},
+			// Update testing
+			{
+				// Update test
+				Config: tests.ConcatTests(testAccEdgeGatewayResourceConfig, testAccVPNIPSecResourceConfigUpdate),
+				Check:  vpnIPSecTestCheckUpdated(resourceName),
+			},
+			// Import State testing
+			{
+				// Import test
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccVPNIPSecResourceImportStateIDFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"status", "ike_service_status", "ike_fail_reason"},
+			},
+			// Revert to the default security profile
+			{
+				Config: tests.ConcatTests(testAccEdgeGatewayResourceConfig, testAccVPNIPSecResourceConfig),
+				Check:  vpnIPSecTestCheck(resourceName),
+			},
+		},
+	})
+}
+
+func testAccVPNIPSecResourceImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s.%s", rs.Primary.Attributes["edge_gateway_name"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -0,0 +1,32 @@
+// Package iam provides the acceptance tests for the provider.
+package iam
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+)
+
+const testAccGroupDataSourceConfig = `
+data "cloudavenue_iam_group" "example" {
+	name = cloudavenue_iam_group.example.name
+}
+`
+
+func TestAccGroupDataSource(t *testing.T) {
+	datasourceName := "data.cloudavenue_iam_group.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tests.ConcatTests(testAccGroupResourceConfig, testAccGroupDataSourceConfig),
+				Check:  testsGroupResourceConfig(datasourceName),
+			},
+		},
+	})
+}
@@ -0,0 +1,70 @@
+// Package iam provides the acceptance tests for the provider.
+package iam
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+const testAccGroupResourceConfig = `
+resource "cloudavenue_iam_group" "example" {
+	name        = "OrgTest"
+	role_name   = "Organization Administrator"
+	description = "org test from go test"
+}
+`
+
+const testAccGroupResourceConfigUpdate = `
+resource "cloudavenue_iam_group" "example" {
+	name        = "OrgTest"
+	role_name   = "Organization Guest"
+	description = "org test from go test updated"
+}
+`
+
+func testsGroupResourceConfig(resourceName string) resource.TestCheckFunc {
+	return resource.ComposeAggregateTestCheckFunc(
+		resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(uuid.Group.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+		resource.TestCheckResourceAttr(resourceName, "name", "OrgTest"),
+		resource.TestCheckResourceAttr(resourceName, "role_name", "Organization Administrator"),
+		resource.TestCheckResourceAttr(resourceName, "description", "org test from go test"),
+		resource.TestCheckResourceAttr(resourceName, "provider_type", "INTEGRATED"),
+		resource.TestCheckResourceAttrSet(resourceName, "user_names.#"),
+	)
+}
+
+func TestAccGroupResource(t *testing.T) {
+	resourceName := "cloudavenue_iam_group.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create testing
+			{
+				Config: testAccGroupResourceConfig,
+				Check:  testsGroupResourceConfig(resourceName),
+			},
+			// Update testing
+			{
+				Config: testAccGroupResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role_name", "Organization Guest"),
+					resource.TestCheckResourceAttr(resourceName, "description", "org test from go test updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "OrgTest",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
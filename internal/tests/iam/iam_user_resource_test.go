@@ -38,6 +38,7 @@ func testsOrgUserResourceConfig(resourceName string) resource.TestCheckFunc {
 		resource.TestCheckResourceAttr(resourceName, "name", "example"),
 		resource.TestCheckResourceAttr(resourceName, "role_name", "Organization Administrator"),
 		resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+		resource.TestCheckResourceAttr(resourceName, "group_names.#", "0"),
 	)
 }
 
@@ -1,6 +1,8 @@
 package network
 
 import (
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -153,3 +155,65 @@ func TestAccDhcpResource(t *testing.T) {
 		},
 	})
 }
+
+const testAccDhcpResourceIPv6Config = `
+resource "cloudavenue_network_dhcp" "example" {
+	org_network_id = cloudavenue_network_routed.example.id
+	mode           = "EDGE"
+	pools = [
+	  {
+		start_address = "192.168.1.30"
+		end_address   = "192.168.1.100"
+	  }
+	]
+	ipv6_mode = "SLAAC"
+	ipv6_dns_servers = [
+	  "2001:4860:4860::8888"
+	]
+	ipv6_domain_names = [
+	  "example.com"
+	]
+}
+
+data "cloudavenue_edgegateways" "example" {}
+
+resource "cloudavenue_network_routed" "example" {
+	name        = "MyOrgNetDualStack"
+	description = "This is an example Net"
+
+	edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+
+	gateway       = "192.168.1.254"
+	prefix_length = 24
+
+	ipv6_gateway       = "2001:db8:1::1"
+	ipv6_prefix_length = 64
+}
+`
+
+func TestAccDhcpResourceIPv6(t *testing.T) {
+	resourceName := "cloudavenue_network_dhcp.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Apply test
+				Config: testAccDhcpResourceIPv6Config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv6_mode", "SLAAC"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_dns_servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_dns_servers.0", "2001:4860:4860::8888"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_domain_names.0", "example.com"),
+				),
+			},
+			{
+				// The DNS configuration is only allowed in SLAAC mode
+				Config:      strings.Replace(testAccDhcpResourceIPv6Config, `"SLAAC"`, `"DHCPv6"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`ipv6_dns_servers`),
+			},
+		},
+	})
+}
@@ -150,3 +150,99 @@ func newUpdatedConfig() string {
 
 	return s
 }
+
+const testAccNetworkRoutedResourceDualStackConfig = `
+data "cloudavenue_edgegateway" "example" {
+  name = "tn01e02ocb0006205spt101"
+}
+
+resource "cloudavenue_network_routed" "example" {
+  name = "OrgNetExampleDualStack"
+
+  edge_gateway_id = data.cloudavenue_edgegateway.example.id
+
+  gateway       = "192.168.10.254"
+  prefix_length = 24
+
+  static_ip_pool = [
+    {
+      start_address = "192.168.10.10"
+      end_address   = "192.168.10.20"
+    }
+  ]
+
+  ipv6_gateway       = "2001:db8:10::1"
+  ipv6_prefix_length = 64
+
+  ipv6_static_ip_pool = [
+    {
+      start_address = "2001:db8:10::10"
+      end_address   = "2001:db8:10::20"
+    }
+  ]
+}
+`
+
+const testAccNetworkRoutedResourceMixedPoolConfig = `
+data "cloudavenue_edgegateway" "example" {
+  name = "tn01e02ocb0006205spt101"
+}
+
+resource "cloudavenue_network_routed" "example" {
+  name = "OrgNetExampleMixedPool"
+
+  edge_gateway_id = data.cloudavenue_edgegateway.example.id
+
+  gateway       = "192.168.10.254"
+  prefix_length = 24
+
+  static_ip_pool = [
+    {
+      start_address = "192.168.10.10"
+      end_address   = "2001:db8:10::20"
+    }
+  ]
+}
+`
+
+func TestAccNetworkRoutedResourceDualStack(t *testing.T) {
+	const resourceName = "cloudavenue_network_routed.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Mixed IP families in a pool are rejected
+			{
+				Config:      testAccNetworkRoutedResourceMixedPoolConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Mixed IP families`),
+			},
+			// Apply test
+			{
+				Config: testAccNetworkRoutedResourceDualStackConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(uuid.Network.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttr(resourceName, "gateway", "192.168.10.254"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_gateway", "2001:db8:10::1"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_prefix_length", "64"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_static_ip_pool.0.start_address", "2001:db8:10::10"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_static_ip_pool.0.end_address", "2001:db8:10::20"),
+				),
+			},
+			// Update test
+			{
+				Config: strings.Replace(testAccNetworkRoutedResourceDualStackConfig, "2001:db8:10::20", "2001:db8:10::30", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv6_static_ip_pool.0.end_address", "2001:db8:10::30"),
+				),
+			},
+			// Import test
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "HackathonShared.OrgNetExampleDualStack",
+			},
+		},
+	})
+}
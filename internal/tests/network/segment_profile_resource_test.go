@@ -0,0 +1,79 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+)
+
+const testAccSegmentProfileResourceNetworkConfig = `
+data "cloudavenue_edgegateways" "example" {}
+
+resource "cloudavenue_network_routed" "example" {
+	name        = "MyOrgNetSegmentProfile"
+	description = "This is an example Net"
+
+	edge_gateway_id = data.cloudavenue_edgegateways.example.edge_gateways[0].id
+
+	gateway       = "192.168.1.254"
+	prefix_length = 24
+}
+`
+
+const testAccSegmentProfileResourceConfig = `
+data "cloudavenue_network_segment_profile_templates" "example" {}
+
+resource "cloudavenue_network_segment_profile" "example" {
+	org_network_id              = cloudavenue_network_routed.example.id
+	segment_profile_template_id = data.cloudavenue_network_segment_profile_templates.example.segment_profile_templates[0].id
+}
+`
+
+const testAccSegmentProfileResourceConfigUpdate = `
+data "cloudavenue_network_segment_profiles" "example" {}
+
+resource "cloudavenue_network_segment_profile" "example" {
+	org_network_id          = cloudavenue_network_routed.example.id
+	ip_discovery_profile_id = data.cloudavenue_network_segment_profiles.example.ip_discovery_profiles[0].id
+	spoof_guard_profile_id  = data.cloudavenue_network_segment_profiles.example.spoof_guard_profiles[0].id
+}
+`
+
+func TestAccSegmentProfileResource(t *testing.T) {
+	resourceName := "cloudavenue_network_segment_profile.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Apply test
+				Config: tests.ConcatTests(testAccSegmentProfileResourceNetworkConfig, testAccSegmentProfileResourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "org_network_id", "cloudavenue_network_routed.example", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "segment_profile_template_id", "data.cloudavenue_network_segment_profile_templates.example", "segment_profile_templates.0.id"),
+				),
+			},
+			{
+				// Update test
+				Config: tests.ConcatTests(testAccSegmentProfileResourceNetworkConfig, testAccSegmentProfileResourceConfigUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckNoResourceAttr(resourceName, "segment_profile_template_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "ip_discovery_profile_id", "data.cloudavenue_network_segment_profiles.example", "ip_discovery_profiles.0.id"),
+					resource.TestCheckResourceAttrPair(resourceName, "spoof_guard_profile_id", "data.cloudavenue_network_segment_profiles.example", "spoof_guard_profiles.0.id"),
+				),
+			},
+			// Import State testing
+			{
+				// Import test
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+)
+
+const testAccSegmentProfileTemplatesDataSourceConfig = `
+data "cloudavenue_network_segment_profile_templates" "example" {}
+`
+
+func TestAccSegmentProfileTemplatesDataSource(t *testing.T) {
+	dataSourceName := "data.cloudavenue_network_segment_profile_templates.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSegmentProfileTemplatesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "segment_profile_templates.#"),
+				),
+			},
+		},
+	})
+}
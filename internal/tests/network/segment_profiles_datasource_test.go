@@ -0,0 +1,37 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+)
+
+const testAccSegmentProfilesDataSourceConfig = `
+data "cloudavenue_network_segment_profiles" "example" {}
+`
+
+func TestAccSegmentProfilesDataSource(t *testing.T) {
+	dataSourceName := "data.cloudavenue_network_segment_profiles.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSegmentProfilesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vdc"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ip_discovery_profiles.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "mac_discovery_profiles.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spoof_guard_profiles.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "qos_profiles.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "segment_security_profiles.#"),
+				),
+			},
+		},
+	})
+}
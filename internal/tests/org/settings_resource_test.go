@@ -0,0 +1,86 @@
+package org
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+)
+
+const testAccOrgSettingsResourceConfig = `
+resource "cloudavenue_org_settings" "example" {
+	vapp_lease = {
+		runtime_lease_in_sec                  = 0
+		storage_lease_in_sec                  = 2592000
+		delete_on_storage_lease_expiration    = false
+		power_off_on_runtime_lease_expiration = true
+	}
+
+	vapp_template_lease = {
+		storage_lease_in_sec               = 0
+		delete_on_storage_lease_expiration = false
+	}
+
+	deployed_vm_quota = 0
+	stored_vm_quota   = 0
+}
+`
+
+const testAccOrgSettingsResourceConfigUpdate = `
+resource "cloudavenue_org_settings" "example" {
+	vapp_lease = {
+		storage_lease_in_sec = 604800
+	}
+
+	deployed_vm_quota = 10
+	stored_vm_quota   = 20
+}
+`
+
+func TestAccOrgSettingsResource(t *testing.T) {
+	resourceName := "cloudavenue_org_settings.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Apply test
+			{
+				Config: testAccOrgSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "vapp_lease.runtime_lease_in_sec", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vapp_lease.storage_lease_in_sec", "2592000"),
+					resource.TestCheckResourceAttr(resourceName, "vapp_lease.delete_on_storage_lease_expiration", "false"),
+					resource.TestCheckResourceAttr(resourceName, "vapp_lease.power_off_on_runtime_lease_expiration", "true"),
+					resource.TestCheckResourceAttr(resourceName, "vapp_template_lease.storage_lease_in_sec", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vapp_template_lease.delete_on_storage_lease_expiration", "false"),
+					resource.TestCheckResourceAttr(resourceName, "deployed_vm_quota", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stored_vm_quota", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "ldap.mode"),
+					resource.TestCheckResourceAttrSet(resourceName, "saml.enabled"),
+				),
+			},
+			// Update test, the settings that are not set are kept unchanged
+			{
+				Config: testAccOrgSettingsResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "vapp_lease.runtime_lease_in_sec", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vapp_lease.storage_lease_in_sec", "604800"),
+					resource.TestCheckResourceAttr(resourceName, "vapp_lease.power_off_on_runtime_lease_expiration", "true"),
+					resource.TestCheckResourceAttr(resourceName, "vapp_template_lease.storage_lease_in_sec", "0"),
+					resource.TestCheckResourceAttr(resourceName, "deployed_vm_quota", "10"),
+					resource.TestCheckResourceAttr(resourceName, "stored_vm_quota", "20"),
+				),
+			},
+			// Import State testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "org",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
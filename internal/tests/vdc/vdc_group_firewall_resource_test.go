@@ -0,0 +1,64 @@
+package vdc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+//go:generate tf-doc-extractor -filename $GOFILE -example-dir ../../../examples -test
+const testAccVDCGroupFirewallResourceConfig = `
+resource "cloudavenue_vdc_group_firewall" "example" {
+  vdc_group_name = "MyVDCGroup"
+  rules = [
+    {
+      name      = "allow all IPv4 traffic"
+      direction = "IN_OUT"
+      action    = "ALLOW"
+    },
+    {
+      name        = "drop IPv6 traffic"
+      direction   = "IN_OUT"
+      ip_protocol = "IPV6"
+      action      = "DROP"
+      logging     = true
+    }
+  ]
+}
+`
+
+func TestAccVDCGroupFirewallResource(t *testing.T) {
+	const resourceName = "cloudavenue_vdc_group_firewall.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Apply test
+				Config: testAccVDCGroupFirewallResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(uuid.VDCGroup.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "vdc_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "vdc_group_name", "MyVDCGroup"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ip_protocol", "IPV4"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.action", "DROP"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.logging", "true"),
+				),
+			},
+			{
+				// Import test
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "MyVDCGroup",
+			},
+		},
+	})
+}
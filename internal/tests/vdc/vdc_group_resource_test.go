@@ -0,0 +1,79 @@
+package vdc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+//go:generate tf-doc-extractor -filename $GOFILE -example-dir ../../../examples -test
+const testAccVDCGroupResourceConfig = `
+data "cloudavenue_vdc" "example" {
+  name = "VDC_Test"
+}
+
+resource "cloudavenue_vdc_group" "example" {
+  name        = "MyVDCGroup"
+  description = "This is an example vDC Group"
+  vdc_ids = [
+    data.cloudavenue_vdc.example.id
+  ]
+  dfw_enabled = true
+}
+`
+
+const testAccVDCGroupResourceConfigUpdate = `
+data "cloudavenue_vdc" "example" {
+  name = "VDC_Test"
+}
+
+resource "cloudavenue_vdc_group" "example" {
+  name        = "MyVDCGroupUpdated"
+  vdc_ids = [
+    data.cloudavenue_vdc.example.id
+  ]
+}
+`
+
+func TestAccVDCGroupResource(t *testing.T) {
+	const resourceName = "cloudavenue_vdc_group.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Apply test
+				Config: testAccVDCGroupResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(uuid.VDCGroup.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttr(resourceName, "name", "MyVDCGroup"),
+					resource.TestCheckResourceAttr(resourceName, "description", "This is an example vDC Group"),
+					resource.TestCheckResourceAttr(resourceName, "vdc_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "type", "LOCAL"),
+					resource.TestCheckResourceAttr(resourceName, "dfw_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				// Update test
+				Config: testAccVDCGroupResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "MyVDCGroupUpdated"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "dfw_enabled", "false"),
+				),
+			},
+			{
+				// Import test
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "MyVDCGroupUpdated",
+			},
+		},
+	})
+}
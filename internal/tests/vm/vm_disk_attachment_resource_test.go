@@ -0,0 +1,90 @@
+package vm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+//go:generate tf-doc-extractor -filename $GOFILE -example-dir ../../../examples -test
+const testAccVMDiskAttachmentResourceConfig = `
+resource "cloudavenue_vapp" "example" {
+  name        = "vapp_example"
+  description = "This is a example vapp"
+}
+
+data "cloudavenue_catalog_vapp_template" "example" {
+  catalog_name  = "Orange-Linux"
+  template_name = "debian_10_X64"
+}
+
+resource "cloudavenue_vm" "example" {
+  name      = "example-vm"
+  vapp_name = cloudavenue_vapp.example.name
+  deploy_os = {
+    vapp_template_id = data.cloudavenue_catalog_vapp_template.example.id
+  }
+  settings = {
+    customization = {}
+  }
+  resource = {}
+  state    = {}
+}
+
+resource "cloudavenue_disk" "example" {
+  name       = "disk-example"
+  size_in_mb = 2048
+  bus_type   = "SATA"
+}
+
+resource "cloudavenue_vm_disk_attachment" "example" {
+  vapp_name = cloudavenue_vapp.example.name
+  vm_name   = cloudavenue_vm.example.name
+  disk_id   = cloudavenue_disk.example.id
+}
+`
+
+func TestAccVMDiskAttachmentResource(t *testing.T) {
+	const resourceName = "cloudavenue_vm_disk_attachment.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Apply test
+				Config: testAccVMDiskAttachmentResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(uuid.Disk.String()+`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)),
+					resource.TestCheckResourceAttrPair(resourceName, "disk_id", "cloudavenue_disk.example", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vm_name", "example-vm"),
+					resource.TestCheckResourceAttrSet(resourceName, "bus_number"),
+					resource.TestCheckResourceAttrSet(resourceName, "unit_number"),
+				),
+			},
+			{
+				// Import test
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVMDiskAttachmentResourceImportStateIDFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccVMDiskAttachmentResourceImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s.%s.%s", rs.Primary.Attributes["vapp_name"], rs.Primary.Attributes["vm_name"], rs.Primary.ID), nil
+	}
+}
@@ -92,6 +92,8 @@ resource "cloudavenue_vm" "example" {
 	  }
   
 	state = {
+		power_action     = "shutdown"
+		shutdown_timeout = 120
 	}
   }
 `
@@ -178,6 +180,10 @@ func TestAccVMResource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceNameVM, "state.power_on", "true"),
 					// ? status
 					resource.TestCheckResourceAttr(resourceNameVM, "state.status", "POWERED_ON"),
+					// ? power_action
+					resource.TestCheckResourceAttr(resourceNameVM, "state.power_action", "power_off"),
+					// ? shutdown_timeout
+					resource.TestCheckResourceAttr(resourceNameVM, "state.shutdown_timeout", "300"),
 
 					// ! resource
 					// ? cpus
@@ -270,6 +276,10 @@ func TestAccVMResource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceNameVM, "state.power_on", "true"),
 					// ? status
 					resource.TestCheckResourceAttr(resourceNameVM, "state.status", "POWERED_ON"),
+					// ? power_action
+					resource.TestCheckResourceAttr(resourceNameVM, "state.power_action", "shutdown"),
+					// ? shutdown_timeout
+					resource.TestCheckResourceAttr(resourceNameVM, "state.shutdown_timeout", "120"),
 
 					// ! resource
 					// ? cpus
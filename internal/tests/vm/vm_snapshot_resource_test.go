@@ -0,0 +1,87 @@
+// Package vm provides the acceptance tests for the provider.
+package vm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tests "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/tests/common"
+)
+
+const testAccVMSnapshotResourceConfig = `
+resource "cloudavenue_vapp" "example" {
+	name = "vapp_example_snapshot"
+	description = "This is a example vapp"
+}
+
+data "cloudavenue_catalog_vapp_template" "example" {
+	catalog_name = "Orange-Linux"
+	template_name    = "debian_10_X64"
+}
+
+resource "cloudavenue_vm" "example" {
+	name      = "example-vm-snapshot"
+	vapp_name = cloudavenue_vapp.example.name
+	deploy_os = {
+	  vapp_template_id = data.cloudavenue_catalog_vapp_template.example.id
+	}
+	settings = {
+	  customization = {}
+	}
+
+	resource = {}
+	state = {}
+}
+
+resource "cloudavenue_vm_snapshot" "example" {
+	vapp_name   = cloudavenue_vapp.example.name
+	vm_id       = cloudavenue_vm.example.id
+	description = "snapshot before patching"
+	memory      = true
+}
+`
+
+func TestAccVMSnapshotResource(t *testing.T) {
+	resourceName := "cloudavenue_vm_snapshot.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tests.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: tests.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create testing
+			{
+				Config: testAccVMSnapshotResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "cloudavenue_vm.example", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vm_name", "example-vm-snapshot"),
+					resource.TestCheckResourceAttr(resourceName, "description", "snapshot before patching"),
+					resource.TestCheckResourceAttr(resourceName, "memory", "true"),
+					resource.TestCheckResourceAttr(resourceName, "quiesce", "false"),
+					resource.TestCheckResourceAttr(resourceName, "powered_on", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "size"),
+					resource.TestCheckNoResourceAttr(resourceName, "revert_trigger"),
+				),
+			},
+			// Revert testing
+			{
+				Config: strings.Replace(testAccVMSnapshotResourceConfig, `memory      = true`, `memory      = true
+	revert_trigger = "run-1"`, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "cloudavenue_vm.example", "id"),
+					resource.TestCheckResourceAttr(resourceName, "revert_trigger", "run-1"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           "vapp_example_snapshot.example-vm-snapshot",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"description", "memory", "revert_trigger"},
+			},
+		},
+	})
+}
@@ -26,6 +26,11 @@ const (
 	VAPP              = VcloudUUID(VcloudUUIDPrefix + "vapp:")
 	Disk              = VcloudUUID(VcloudUUIDPrefix + "disk:")
 	SecurityGroup     = VcloudUUID(VcloudUUIDPrefix + "firewallGroup:")
+	Catalog           = VcloudUUID(VcloudUUIDPrefix + "catalog:")
+	VDCGroup          = VcloudUUID(VcloudUUIDPrefix + "vdcGroup:")
+	Certificate       = VcloudUUID(VcloudUUIDPrefix + "certificateLibraryItem:")
+
+	LoadBalancerVirtualService = VcloudUUID(VcloudUUIDPrefix + "loadBalancerVirtualService:")
 
 	// * CLOUDAVENUE.
 	VCDA = VcloudUUID(CloudAvenueUUIDPrefix + "vcda:")
@@ -39,10 +44,14 @@ var vcloudUUIDs = []VcloudUUID{
 	VDC,
 	Network,
 	LoadBalancerPool,
+	LoadBalancerVirtualService,
 	VDCStorageProfile,
 	VAPP,
 	Disk,
 	SecurityGroup,
+	Catalog,
+	VDCGroup,
+	Certificate,
 }
 
 type (
@@ -158,6 +167,11 @@ func (uuid VcloudUUID) IsLoadBalancerPool() bool {
 	return uuid.IsType(LoadBalancerPool)
 }
 
+// IsLoadBalancerVirtualService returns true if the UUID is a LoadBalancerVirtualService UUID.
+func (uuid VcloudUUID) IsLoadBalancerVirtualService() bool {
+	return uuid.IsType(LoadBalancerVirtualService)
+}
+
 // IsVDCStorageProfile returns true if the UUID is a VDCStorageProfile UUID.
 func (uuid VcloudUUID) IsVDCStorageProfile() bool {
 	return uuid.IsType(VDCStorageProfile)
@@ -178,6 +192,21 @@ func (uuid VcloudUUID) IsSecurityGroup() bool {
 	return uuid.IsType(SecurityGroup)
 }
 
+// IsCatalog returns true if the UUID is a Catalog UUID.
+func (uuid VcloudUUID) IsCatalog() bool {
+	return uuid.IsType(Catalog)
+}
+
+// IsVDCGroup returns true if the UUID is a VDCGroup UUID.
+func (uuid VcloudUUID) IsVDCGroup() bool {
+	return uuid.IsType(VDCGroup)
+}
+
+// IsCertificate returns true if the UUID is a Certificate UUID.
+func (uuid VcloudUUID) IsCertificate() bool {
+	return uuid.IsType(Certificate)
+}
+
 // IsEdgeGateway returns true if the UUID is a EdgeGateway UUID.
 func IsEdgeGateway(uuid string) bool {
 	return VcloudUUID(uuid).IsType(Gateway)
@@ -198,6 +227,11 @@ func IsLoadBalancerPool(uuid string) bool {
 	return VcloudUUID(uuid).IsType(LoadBalancerPool)
 }
 
+// IsLoadBalancerVirtualService returns true if the UUID is a LoadBalancerVirtualService UUID.
+func IsLoadBalancerVirtualService(uuid string) bool {
+	return VcloudUUID(uuid).IsType(LoadBalancerVirtualService)
+}
+
 // IsVDCStorageProfile returns true if the UUID is a VDCStorageProfile UUID.
 func IsVDCStorageProfile(uuid string) bool {
 	return VcloudUUID(uuid).IsType(VDCStorageProfile)
@@ -218,6 +252,21 @@ func IsSecurityGroup(uuid string) bool {
 	return VcloudUUID(uuid).IsType(SecurityGroup)
 }
 
+// IsCatalog returns true if the UUID is a Catalog UUID.
+func IsCatalog(uuid string) bool {
+	return VcloudUUID(uuid).IsType(Catalog)
+}
+
+// IsVDCGroup returns true if the UUID is a VDCGroup UUID.
+func IsVDCGroup(uuid string) bool {
+	return VcloudUUID(uuid).IsType(VDCGroup)
+}
+
+// IsCertificate returns true if the UUID is a Certificate UUID.
+func IsCertificate(uuid string) bool {
+	return VcloudUUID(uuid).IsType(Certificate)
+}
+
 // IsVCDA returns true if the UUID is a VCDA UUID.
 func IsVCDA(uuid string) bool {
 	return VcloudUUID(uuid).IsType(VCDA)
@@ -456,6 +456,38 @@ func TestVcloudUUID_IsLoadBalancerPool(t *testing.T) {
 	}
 }
 
+// IsLoadBalancerVirtualService.
+func TestVcloudUUID_IsLoadBalancerVirtualService(t *testing.T) {
+	tests := []struct {
+		name string
+		uuid VcloudUUID
+		want bool
+	}{
+		{
+			name: "IsLoadBalancerVirtualService",
+			uuid: VcloudUUID(LoadBalancerVirtualService.String() + validUUIDv4),
+			want: true,
+		},
+		{
+			name: "IsNotLoadBalancerVirtualService",
+			uuid: VcloudUUID("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			want: false,
+		},
+		{ // Empty string
+			name: "EmptyString",
+			uuid: VcloudUUID(""),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.IsLoadBalancerVirtualService(); got != tt.want {
+				t.Errorf("VcloudUUID.IsLoadBalancerVirtualService() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // IsVDCStorageProfile.
 func TestVcloudUUID_IsVDCStorageProfile(t *testing.T) {
 	tests := []struct {
@@ -584,6 +616,102 @@ func TestVcloudUUID_IsSecurityGroup(t *testing.T) {
 	}
 }
 
+// IsCatalog.
+func TestVcloudUUID_IsCatalog(t *testing.T) {
+	tests := []struct {
+		name string
+		uuid VcloudUUID
+		want bool
+	}{
+		{
+			name: "IsCatalog",
+			uuid: VcloudUUID(Catalog.String() + validUUIDv4),
+			want: true,
+		},
+		{
+			name: "IsNotCatalog",
+			uuid: VcloudUUID("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			want: false,
+		},
+		{ // Empty string
+			name: "EmptyString",
+			uuid: VcloudUUID(""),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.IsCatalog(); got != tt.want {
+				t.Errorf("VcloudUUID.IsCatalog() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// IsVDCGroup.
+func TestVcloudUUID_IsVDCGroup(t *testing.T) {
+	tests := []struct {
+		name string
+		uuid VcloudUUID
+		want bool
+	}{
+		{
+			name: "IsVDCGroup",
+			uuid: VcloudUUID(VDCGroup.String() + validUUIDv4),
+			want: true,
+		},
+		{
+			name: "IsNotCatalog",
+			uuid: VcloudUUID("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			want: false,
+		},
+		{ // Empty string
+			name: "EmptyString",
+			uuid: VcloudUUID(""),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.IsVDCGroup(); got != tt.want {
+				t.Errorf("VcloudUUID.IsVDCGroup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// IsCertificate.
+func TestVcloudUUID_IsCertificate(t *testing.T) {
+	tests := []struct {
+		name string
+		uuid VcloudUUID
+		want bool
+	}{
+		{
+			name: "IsCertificate",
+			uuid: VcloudUUID(Certificate.String() + validUUIDv4),
+			want: true,
+		},
+		{
+			name: "IsNotCertificate",
+			uuid: VcloudUUID("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			want: false,
+		},
+		{ // Empty string
+			name: "EmptyString",
+			uuid: VcloudUUID(""),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.IsCertificate(); got != tt.want {
+				t.Errorf("VcloudUUID.IsCertificate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // TestIsType tests the TestIsType function.
 func TestTestIsType(t *testing.T) {
 	testCases := []struct {